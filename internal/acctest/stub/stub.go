@@ -0,0 +1,31 @@
+// Package stub provides AWS service clients for unit tests that do not send requests to AWS.
+// It does not depend on the provider, so it can be used by tests within service packages.
+package stub
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// Session returns an AWS session to create service clients for unit tests.
+func Session(t *testing.T) *session.Session {
+	t.Helper()
+
+	sess, err := session.NewSession(nil)
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	return sess
+}
+
+// Send replaces the request handlers of an AWS service client, e.g. conn.Client,
+// so that its requests are not sent to AWS. Instead, send is called to populate each request's Data or Error.
+func Send(c *client.Client, send func(*request.Request)) {
+	c.Handlers.Clear()
+	c.Handlers.Send.PushBack(send)
+}
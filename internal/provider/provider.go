@@ -133,6 +133,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ses"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/shield"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/signer"
@@ -382,6 +383,8 @@ func Provider() *schema.Provider {
 
 			"aws_cloudtrail_service_account": cloudtrail.DataSourceServiceAccount(),
 
+			"aws_cloudwatch_event_bus_policy": cloudwatchevents.DataSourceBusPolicy(),
+			"aws_cloudwatch_event_buses":      cloudwatchevents.DataSourceBuses(),
 			"aws_cloudwatch_event_connection": cloudwatchevents.DataSourceConnection(),
 			"aws_cloudwatch_event_source":     cloudwatchevents.DataSourceSource(),
 
@@ -395,6 +398,10 @@ func Provider() *schema.Provider {
 
 			"aws_codestarconnections_connection": codestarconnections.DataSourceConnection(),
 
+			"aws_config_configuration_aggregator_compliance":  config.DataSourceConfigurationAggregatorCompliance(),
+			"aws_config_configuration_recorders":              config.DataSourceConfigurationRecorders(),
+			"aws_config_organization_conformance_pack_status": config.DataSourceOrganizationConformancePackStatus(),
+
 			"aws_cognito_user_pools": cognitoidp.DataSourceUserPools(),
 
 			"aws_connect_contact_flow": connect.DataSourceContactFlow(),
@@ -644,6 +651,9 @@ func Provider() *schema.Provider {
 			"aws_s3_bucket_object":  s3.DataSourceBucketObject(),
 			"aws_s3_bucket_objects": s3.DataSourceBucketObjects(),
 
+			"aws_s3_access_point":  s3control.DataSourceAccessPoint(),
+			"aws_s3_access_points": s3control.DataSourceAccessPoints(),
+
 			"aws_sagemaker_prebuilt_ecr_image": sagemaker.DataSourcePrebuiltECRImage(),
 
 			"aws_secretsmanager_secret":          secretsmanager.DataSourceSecret(),
@@ -663,6 +673,8 @@ func Provider() *schema.Provider {
 			"aws_servicequotas_service":       servicequotas.DataSourceService(),
 			"aws_servicequotas_service_quota": servicequotas.DataSourceServiceQuota(),
 
+			"aws_sesv2_configuration_set": sesv2.DataSourceConfigurationSet(),
+
 			"aws_sfn_activity":      sfn.DataSourceActivity(),
 			"aws_sfn_state_machine": sfn.DataSourceStateMachine(),
 
@@ -932,6 +944,7 @@ func Provider() *schema.Provider {
 			"aws_config_organization_custom_rule":      config.ResourceOrganizationCustomRule(),
 			"aws_config_organization_managed_rule":     config.ResourceOrganizationManagedRule(),
 			"aws_config_remediation_configuration":     config.ResourceRemediationConfiguration(),
+			"aws_config_rule_evaluation":               config.ResourceRuleEvaluation(),
 
 			"aws_connect_contact_flow": connect.ResourceContactFlow(),
 			"aws_connect_instance":     connect.ResourceInstance(),
@@ -1475,11 +1488,12 @@ func Provider() *schema.Provider {
 			"aws_s3_bucket_public_access_block":     s3.ResourceBucketPublicAccessBlock(),
 			"aws_s3_object_copy":                    s3.ResourceObjectCopy(),
 
-			"aws_s3_access_point":                          s3control.ResourceAccessPoint(),
-			"aws_s3_account_public_access_block":           s3control.ResourceAccountPublicAccessBlock(),
-			"aws_s3control_bucket":                         s3control.ResourceBucket(),
-			"aws_s3control_bucket_lifecycle_configuration": s3control.ResourceBucketLifecycleConfiguration(),
-			"aws_s3control_bucket_policy":                  s3control.ResourceBucketPolicy(),
+			"aws_s3_access_point":                            s3control.ResourceAccessPoint(),
+			"aws_s3_account_public_access_block":             s3control.ResourceAccountPublicAccessBlock(),
+			"aws_s3control_bucket":                           s3control.ResourceBucket(),
+			"aws_s3control_bucket_lifecycle_configuration":   s3control.ResourceBucketLifecycleConfiguration(),
+			"aws_s3control_bucket_policy":                    s3control.ResourceBucketPolicy(),
+			"aws_s3control_multi_region_access_point_policy": s3control.ResourceMultiRegionAccessPointPolicy(),
 
 			"aws_s3outposts_endpoint": s3outposts.ResourceEndpoint(),
 
@@ -1564,6 +1578,13 @@ func Provider() *schema.Provider {
 			"aws_ses_receipt_rule_set":             ses.ResourceReceiptRuleSet(),
 			"aws_ses_template":                     ses.ResourceTemplate(),
 
+			"aws_sesv2_account_sending_attributes":          sesv2.ResourceAccountSendingAttributes(),
+			"aws_sesv2_configuration_set":                   sesv2.ResourceConfigurationSet(),
+			"aws_sesv2_configuration_set_event_destination": sesv2.ResourceConfigurationSetEventDestination(),
+			"aws_sesv2_dedicated_ip_assignment":             sesv2.ResourceDedicatedIPAssignment(),
+			"aws_sesv2_email_identity_feedback_attributes":  sesv2.ResourceEmailIdentityFeedbackAttributes(),
+			"aws_sesv2_email_identity_mail_from_attributes": sesv2.ResourceEmailIdentityMailFromAttributes(),
+
 			"aws_sfn_activity":      sfn.ResourceActivity(),
 			"aws_sfn_state_machine": sfn.ResourceStateMachine(),
 
@@ -139,13 +139,13 @@ func resourceAPIDestinationRead(d *schema.ResourceData, meta interface{}) error
 func resourceAPIDestinationUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CloudWatchEventsConn
 
-	input := &events.UpdateApiDestinationInput{}
-
-	if name, ok := d.GetOk("name"); ok {
-		input.Name = aws.String(name.(string))
+	// All arguments other than the name are updated in-place.
+	input := &events.UpdateApiDestinationInput{
+		Name: aws.String(d.Id()),
 	}
-	if description, ok := d.GetOk("description"); ok {
-		input.Description = aws.String(description.(string))
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
 	}
 	if invocationEndpoint, ok := d.GetOk("invocation_endpoint"); ok {
 		input.InvocationEndpoint = aws.String(invocationEndpoint.(string))
@@ -1,16 +1,19 @@
 package cloudwatchevents_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/stub"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfcloudwatchevents "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatchevents"
 )
@@ -170,6 +173,122 @@ func TestAccCloudWatchEventsAPIDestination_optional(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchEventsAPIDestination_invocationRateLimitPerSecond(t *testing.T) {
+	var v1, v2 events.DescribeApiDestinationOutput
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	invocationEndpoint := "https://www.hashicorp.com/"
+	httpMethod := "GET"
+	description := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_api_destination.optional"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, events.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAPIDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAPIDestinationConfig_optional(name, invocationEndpoint, httpMethod, description, 0),
+				ExpectError: regexp.MustCompile(`expected invocation_rate_limit_per_second to be in the range \(1 - 300\)`),
+			},
+			{
+				Config:      testAccAPIDestinationConfig_optional(name, invocationEndpoint, httpMethod, description, 301),
+				ExpectError: regexp.MustCompile(`expected invocation_rate_limit_per_second to be in the range \(1 - 300\)`),
+			},
+			{
+				Config: testAccAPIDestinationConfig_optional(name, invocationEndpoint, httpMethod, description, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventApiDestinationExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "invocation_rate_limit_per_second", "1"),
+				),
+			},
+			{
+				Config: testAccAPIDestinationConfig_optional(name, invocationEndpoint, httpMethod, description, 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventApiDestinationExists(resourceName, &v2),
+					testAccCheckCloudWatchEventApiDestinationNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "invocation_rate_limit_per_second", "300"),
+				),
+			},
+		},
+	})
+}
+
+func TestAPIDestinationUpdate_inPlace(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "test",
+		Attributes: map[string]string{
+			"arn":                              "arn:aws:events:us-west-2:123456789012:api-destination/test/00000000-0000-0000-0000-000000000000", //lintignore:AWSAT003,AWSAT005
+			"connection_arn":                   "arn:aws:events:us-west-2:123456789012:connection/test/00000000-0000-0000-0000-000000000000",      //lintignore:AWSAT003,AWSAT005
+			"description":                      "",
+			"http_method":                      "GET",
+			"id":                               "test",
+			"invocation_endpoint":              "https://example.com/",
+			"invocation_rate_limit_per_second": "300",
+			"name":                             "test",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"connection_arn":                   "arn:aws:events:us-west-2:123456789012:connection/other/00000000-0000-0000-0000-000000000000", //lintignore:AWSAT003,AWSAT005
+		"http_method":                      "POST",
+		"invocation_endpoint":              "https://example.com/other",
+		"invocation_rate_limit_per_second": 10,
+		"name":                             "test",
+	})
+
+	var update *events.UpdateApiDestinationInput
+	var operations []string
+
+	conn := events.New(stub.Session(t))
+	stub.Send(conn.Client, func(r *request.Request) {
+		operations = append(operations, r.Operation.Name)
+
+		if input, ok := r.Params.(*events.UpdateApiDestinationInput); ok {
+			update = input
+		}
+
+		if output, ok := r.Data.(*events.DescribeApiDestinationOutput); ok && update != nil {
+			output.ApiDestinationArn = aws.String(state.Attributes["arn"])
+			output.ConnectionArn = update.ConnectionArn
+			output.HttpMethod = update.HttpMethod
+			output.InvocationEndpoint = update.InvocationEndpoint
+			output.InvocationRateLimitPerSecond = update.InvocationRateLimitPerSecond
+			output.Name = aws.String("test")
+		}
+	})
+
+	meta := &conns.AWSClient{CloudWatchEventsConn: conn}
+	r := tfcloudwatchevents.ResourceAPIDestination()
+
+	diff, err := r.Diff(context.Background(), state, config, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff.RequiresNew() {
+		t.Fatal("expected in-place update, got replacement")
+	}
+
+	newState, diags := r.Apply(context.Background(), state, diff, meta)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, expected := fmt.Sprint(operations), "[UpdateApiDestination DescribeApiDestination]"; got != expected {
+		t.Errorf("got operations %s, expected %s", got, expected)
+	}
+
+	if got, expected := aws.Int64Value(update.InvocationRateLimitPerSecond), int64(10); got != expected {
+		t.Errorf("got InvocationRateLimitPerSecond %d, expected %d", got, expected)
+	}
+
+	if got, expected := newState.Attributes["invocation_rate_limit_per_second"], "10"; got != expected {
+		t.Errorf("got invocation_rate_limit_per_second %s, expected %s", got, expected)
+	}
+}
+
 func TestAccCloudWatchEventsAPIDestination_disappears(t *testing.T) {
 	var v events.DescribeApiDestinationOutput
 	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -52,8 +52,9 @@ func ResourceArchive() *schema.Resource {
 				},
 			},
 			"retention_days": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
 			},
 			"arn": {
 				Type:     schema.TypeString,
@@ -111,7 +112,8 @@ func resourceArchiveRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("event_pattern", out.EventPattern)
 	d.Set("event_source_arn", out.EventSourceArn)
 	d.Set("arn", out.ArchiveArn)
-	d.Set("retention_days", out.RetentionDays)
+	// An archive that retains events indefinitely may be returned with a retention of 0 or without one.
+	d.Set("retention_days", aws.Int64Value(out.RetentionDays))
 
 	return nil
 }
@@ -197,9 +199,8 @@ func buildUpdateArchiveInputStruct(d *schema.ResourceData) (*events.UpdateArchiv
 		input.Description = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("retention_days"); ok {
-		input.RetentionDays = aws.Int64(int64(v.(int)))
-	}
+	// Always send the retention period so that changing it to 0 switches the archive to indefinite retention.
+	input.RetentionDays = aws.Int64(int64(d.Get("retention_days").(int)))
 
 	return &input, nil
 }
@@ -1,15 +1,19 @@
 package cloudwatchevents_test
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/stub"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfcloudwatchevents "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatchevents"
 )
@@ -75,6 +79,119 @@ func TestAccCloudWatchEventsArchive_update(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchEventsArchive_retentionIndefinite(t *testing.T) {
+	var v1 events.DescribeArchiveOutput
+	archiveName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_archive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, events.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckArchiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchiveConfig_retention(archiveName, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventArchiveExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "retention_days", "7"),
+				),
+			},
+			{
+				Config: testAccArchiveConfig_retention(archiveName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventArchiveExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "retention_days", "0"),
+					testAccCheckArchiveRetentionDays(&v1, 0),
+				),
+			},
+			{
+				Config:   testAccArchiveConfig(archiveName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccArchiveConfig_retention(archiveName, -1),
+				ExpectError: regexp.MustCompile(`expected retention_days to be at least \(0\)`),
+			},
+		},
+	})
+}
+
+func TestArchiveUpdate_indefiniteRetention(t *testing.T) {
+	var updateInput *events.UpdateArchiveInput
+
+	conn := events.New(stub.Session(t))
+	stub.Send(conn.Client, func(r *request.Request) {
+		switch output := r.Data.(type) {
+		case *events.UpdateArchiveOutput:
+			updateInput = r.Params.(*events.UpdateArchiveInput)
+		case *events.DescribeArchiveOutput:
+			// Indefinite retention is returned without a retention period.
+			output.ArchiveArn = aws.String("arn:aws:events:us-west-2:123456789012:archive/test") //lintignore:AWSAT003,AWSAT005
+			output.ArchiveName = aws.String("test")
+			output.EventSourceArn = aws.String("arn:aws:events:us-west-2:123456789012:event-bus/default") //lintignore:AWSAT003,AWSAT005
+		}
+	})
+
+	state := &terraform.InstanceState{
+		ID: "test",
+		Attributes: map[string]string{
+			"arn":              "arn:aws:events:us-west-2:123456789012:archive/test",      //lintignore:AWSAT003,AWSAT005
+			"event_source_arn": "arn:aws:events:us-west-2:123456789012:event-bus/default", //lintignore:AWSAT003,AWSAT005
+			"id":               "test",
+			"name":             "test",
+			"retention_days":   "7",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"event_source_arn": "arn:aws:events:us-west-2:123456789012:event-bus/default", //lintignore:AWSAT003,AWSAT005
+		"name":             "test",
+		"retention_days":   0,
+	})
+
+	meta := &conns.AWSClient{CloudWatchEventsConn: conn}
+	r := tfcloudwatchevents.ResourceArchive()
+
+	diff, err := r.Diff(context.Background(), state, config, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	newState, diags := r.Apply(context.Background(), state, diff, meta)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if updateInput == nil {
+		t.Fatal("expected UpdateArchive call")
+	}
+
+	if updateInput.RetentionDays == nil || aws.Int64Value(updateInput.RetentionDays) != 0 {
+		t.Errorf("expected UpdateArchive retention days 0, got %v", updateInput.RetentionDays)
+	}
+
+	if got, expected := newState.Attributes["retention_days"], "0"; got != expected {
+		t.Errorf("got retention_days %s, expected %s", got, expected)
+	}
+
+	diff, err = r.Diff(context.Background(), newState, config, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff != nil && !diff.Empty() {
+		t.Errorf("expected no diff after update, got: %#v", diff.Attributes)
+	}
+}
+
 func TestAccCloudWatchEventsArchive_disappears(t *testing.T) {
 	var v events.DescribeArchiveOutput
 	archiveName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -147,6 +264,16 @@ func testAccCheckCloudWatchEventArchiveExists(n string, v *events.DescribeArchiv
 	}
 }
 
+func testAccCheckArchiveRetentionDays(v *events.DescribeArchiveOutput, expected int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.Int64Value(v.RetentionDays); got != expected {
+			return fmt.Errorf("CloudWatch Events archive retention days: got %d, expected %d", got, expected)
+		}
+
+		return nil
+	}
+}
+
 func TestAccCloudWatchEventsArchive_retentionSetOnCreation(t *testing.T) {
 	var v1 events.DescribeArchiveOutput
 	archiveName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -211,6 +338,20 @@ PATTERN
 `, name)
 }
 
+func testAccArchiveConfig_retention(name string, retentionDays int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_archive" "test" {
+  name             = %[1]q
+  event_source_arn = aws_cloudwatch_event_bus.test.arn
+  retention_days   = %[2]d
+}
+`, name, retentionDays)
+}
+
 func testAccArchiveConfig_retentionOnCreation(name string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_bus" "test" {
@@ -1,18 +1,33 @@
 package cloudwatchevents
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
+	"regexp"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	defaultArchiveRetentionDays = 90
+
+	eventSourceFoundTimeout = 2 * time.Minute
+)
+
+var busDefaultArchiveNameInvalidCharsRegexp = regexp.MustCompile(`[^\.\-_A-Za-z0-9]`)
+
 func ResourceBus() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBusCreate,
@@ -20,7 +35,7 @@ func ResourceBus() *schema.Resource {
 		Update: resourceBusUpdate,
 		Delete: resourceBusDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceBusImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -40,6 +55,21 @@ func ResourceBus() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"create_default_archive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"default_archive_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_archive_retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultArchiveRetentionDays,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
@@ -59,7 +89,13 @@ func resourceBusCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if v, ok := d.GetOk("event_source_name"); ok {
-		input.EventSourceName = aws.String(v.(string))
+		eventSourceName := v.(string)
+
+		if err := checkEventSourceAssociable(conn, eventSourceName); err != nil {
+			return fmt.Errorf("Creating CloudWatch Events event bus (%s) failed: %w", eventBusName, err)
+		}
+
+		input.EventSourceName = aws.String(eventSourceName)
 	}
 
 	if len(tags) > 0 {
@@ -68,7 +104,7 @@ func resourceBusCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Creating CloudWatch Events event bus: %v", input)
 
-	_, err := conn.CreateEventBus(input)
+	output, err := conn.CreateEventBus(input)
 	if err != nil {
 		return fmt.Errorf("Creating CloudWatch Events event bus (%s) failed: %w", eventBusName, err)
 	}
@@ -77,9 +113,48 @@ func resourceBusCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] CloudWatch Events event bus (%s) created", d.Id())
 
+	if d.Get("create_default_archive").(bool) {
+		if err := createBusDefaultArchive(conn, d.Id(), aws.StringValue(output.EventBusArn), d.Get("default_archive_retention_days").(int)); err != nil {
+			return err
+		}
+	}
+
 	return resourceBusRead(d, meta)
 }
 
+// checkEventSourceAssociable returns an error if an event bus cannot be associated with the specified partner event source.
+// A partner event source that has only just been created by the partner may not be visible yet.
+func checkEventSourceAssociable(conn *events.CloudWatchEvents, name string) error {
+	outputRaw, err := tfresource.RetryWhenNotFound(eventSourceFoundTimeout, func() (interface{}, error) {
+		return FindEventSourceByName(conn, name)
+	})
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("partner event source (%s) not found", name)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading partner event source (%s): %w", name, err)
+	}
+
+	output := outputRaw.(*events.DescribeEventSourceOutput)
+
+	switch state := aws.StringValue(output.State); state {
+	case events.EventSourceStateActive:
+		return nil
+	case events.EventSourceStatePending:
+		if v := output.ExpirationTime; v != nil && v.Before(time.Now()) {
+			return fmt.Errorf("partner event source (%s) expired at %s without being associated with an event bus", name, v.Format(time.RFC3339))
+		}
+
+		return nil
+	case events.EventSourceStateDeleted:
+		return fmt.Errorf("partner event source (%s) has been deleted", name)
+	default:
+		return fmt.Errorf("partner event source (%s) is in unexpected state (%s)", name, state)
+	}
+}
+
 func resourceBusRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CloudWatchEventsConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -105,6 +180,24 @@ func resourceBusRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("arn", output.Arn)
 	d.Set("name", output.Name)
 
+	if d.Get("create_default_archive").(bool) {
+		archive, err := findBusDefaultArchive(conn, d.Id(), aws.StringValue(output.Arn))
+
+		if tfresource.NotFound(err) {
+			log.Printf("[WARN] CloudWatch Events event bus (%s) default archive not found", d.Id())
+			d.Set("create_default_archive", false)
+			d.Set("default_archive_arn", "")
+		} else if err != nil {
+			return fmt.Errorf("error reading CloudWatch Events event bus (%s) default archive: %w", d.Id(), err)
+		} else {
+			d.Set("default_archive_arn", archive.ArchiveArn)
+			d.Set("default_archive_retention_days", archive.RetentionDays)
+		}
+	} else {
+		d.Set("create_default_archive", false)
+		d.Set("default_archive_arn", "")
+	}
+
 	tags, err := ListTags(conn, aws.StringValue(output.Arn))
 	if err != nil {
 		return fmt.Errorf("error listing tags for CloudWatch Events event bus (%s): %w", d.Id(), err)
@@ -127,6 +220,29 @@ func resourceBusUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CloudWatchEventsConn
 
 	arn := d.Get("arn").(string)
+
+	if d.HasChange("create_default_archive") {
+		if d.Get("create_default_archive").(bool) {
+			if err := createBusDefaultArchive(conn, d.Id(), arn, d.Get("default_archive_retention_days").(int)); err != nil {
+				return err
+			}
+		} else {
+			if err := deleteBusDefaultArchive(conn, d.Id()); err != nil {
+				return err
+			}
+		}
+	} else if d.Get("create_default_archive").(bool) && d.HasChange("default_archive_retention_days") {
+		input := &events.UpdateArchiveInput{
+			ArchiveName:   aws.String(busDefaultArchiveName(d.Id())),
+			RetentionDays: aws.Int64(int64(d.Get("default_archive_retention_days").(int))),
+		}
+
+		log.Printf("[DEBUG] Updating CloudWatch Events event bus (%s) default archive: %s", d.Id(), input)
+		if _, err := conn.UpdateArchive(input); err != nil {
+			return fmt.Errorf("error updating CloudWatch Events event bus (%s) default archive: %w", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -140,6 +256,13 @@ func resourceBusUpdate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceBusDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CloudWatchEventsConn
+
+	if d.Get("create_default_archive").(bool) {
+		if err := deleteBusDefaultArchive(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
 	log.Printf("[INFO] Deleting CloudWatch Events event bus (%s)", d.Id())
 	_, err := conn.DeleteEventBus(&events.DeleteEventBusInput{
 		Name: aws.String(d.Id()),
@@ -155,3 +278,106 @@ func resourceBusDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func resourceBusImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).CloudWatchEventsConn
+
+	output, err := FindEventBusByName(conn, d.Id())
+
+	// The read removes a missing event bus from state, failing the import.
+	if tfresource.NotFound(err) {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading CloudWatch Events event bus (%s): %w", d.Id(), err)
+	}
+
+	_, err = findBusDefaultArchive(conn, d.Id(), aws.StringValue(output.Arn))
+
+	if err != nil && !tfresource.NotFound(err) {
+		return nil, fmt.Errorf("error reading CloudWatch Events event bus (%s) default archive: %w", d.Id(), err)
+	}
+
+	d.Set("create_default_archive", err == nil)
+	d.Set("default_archive_retention_days", defaultArchiveRetentionDays)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// findBusDefaultArchive returns the default archive of the specified event bus.
+// An archive with the default archive name that archives events from a different source is not considered the bus's default archive.
+func findBusDefaultArchive(conn *events.CloudWatchEvents, eventBusName, eventBusARN string) (*events.DescribeArchiveOutput, error) {
+	output, err := FindArchiveByName(conn, busDefaultArchiveName(eventBusName))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if v := aws.StringValue(output.EventSourceArn); v != eventBusARN {
+		return nil, &resource.NotFoundError{
+			Message: fmt.Sprintf("archive (%s) has event source %s, not event bus %s", aws.StringValue(output.ArchiveName), v, eventBusARN),
+		}
+	}
+
+	return output, nil
+}
+
+// busDefaultArchiveName returns the name of the archive created for an event bus by create_default_archive.
+// Archive names are limited to 48 characters from a smaller character set than event bus names.
+// If the event bus name has to be altered to fit, a hash of the full name is appended so that distinct event buses get distinct archive names.
+func busDefaultArchiveName(eventBusName string) string {
+	const (
+		maxLen     = 48
+		suffix     = "-archive"
+		hashLength = 8
+	)
+
+	name := busDefaultArchiveNameInvalidCharsRegexp.ReplaceAllString(eventBusName, "-")
+
+	if name == eventBusName && len(name) <= maxLen-len(suffix) {
+		return name + suffix
+	}
+
+	sum := sha256.Sum256([]byte(eventBusName))
+	hash := hex.EncodeToString(sum[:])[:hashLength]
+
+	if max := maxLen - len(suffix) - len(hash) - 1; len(name) > max {
+		name = name[:max]
+	}
+
+	return name + "-" + hash + suffix
+}
+
+func createBusDefaultArchive(conn *events.CloudWatchEvents, eventBusName, eventBusARN string, retentionDays int) error {
+	input := &events.CreateArchiveInput{
+		ArchiveName:    aws.String(busDefaultArchiveName(eventBusName)),
+		Description:    aws.String(fmt.Sprintf("Default archive for event bus %s", eventBusName)),
+		EventSourceArn: aws.String(eventBusARN),
+		RetentionDays:  aws.Int64(int64(retentionDays)),
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Events event bus (%s) default archive: %s", eventBusName, input)
+	if _, err := conn.CreateArchive(input); err != nil {
+		return fmt.Errorf("error creating CloudWatch Events event bus (%s) default archive: %w", eventBusName, err)
+	}
+
+	return nil
+}
+
+func deleteBusDefaultArchive(conn *events.CloudWatchEvents, eventBusName string) error {
+	log.Printf("[DEBUG] Deleting CloudWatch Events event bus (%s) default archive", eventBusName)
+	_, err := conn.DeleteArchive(&events.DeleteArchiveInput{
+		ArchiveName: aws.String(busDefaultArchiveName(eventBusName)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Events event bus (%s) default archive: %w", eventBusName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package cloudwatchevents
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceBusPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBusPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"event_bus_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      DefaultEventBusName,
+				ValidateFunc: validBusNameOrARN,
+			},
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBusPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudWatchEventsConn
+
+	eventBusName := d.Get("event_bus_name").(string)
+
+	output, err := FindEventBusByName(conn, eventBusName)
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Events bus (%s): %w", eventBusName, err)
+	}
+
+	// An event bus without a policy is reported with an empty policy.
+	var policy string
+
+	if v := aws.StringValue(output.Policy); v != "" {
+		policy, err = structure.NormalizeJsonString(v)
+
+		if err != nil {
+			return fmt.Errorf("error normalizing CloudWatch Events bus (%s) policy: %w", eventBusName, err)
+		}
+	}
+
+	d.SetId(eventBusName)
+	d.Set("arn", output.Arn)
+	d.Set("event_bus_name", eventBusName)
+	d.Set("policy", policy)
+
+	return nil
+}
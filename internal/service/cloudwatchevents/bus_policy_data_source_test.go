@@ -0,0 +1,99 @@
+package cloudwatchevents_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudWatchEventsBusPolicyDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_event_bus_policy.test"
+	busResourceName := "aws_cloudwatch_event_bus.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBusPolicyDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", busResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "event_bus_name", busResourceName, "name"),
+					resource.TestCheckOutput("policy_sid", "test-resource-policy"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchEventsBusPolicyDataSource_noPolicy(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_event_bus_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBusPolicyDataSourceNoPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "policy", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccBusPolicyDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    sid    = "test-resource-policy"
+    effect = "Allow"
+
+    principals {
+      identifiers = ["ecs.amazonaws.com"]
+      type        = "Service"
+    }
+
+    actions   = ["events:PutEvents"]
+    resources = [aws_cloudwatch_event_bus.test.arn]
+  }
+}
+
+resource "aws_cloudwatch_event_bus_policy" "test" {
+  policy         = data.aws_iam_policy_document.test.json
+  event_bus_name = aws_cloudwatch_event_bus.test.name
+}
+
+data "aws_cloudwatch_event_bus_policy" "test" {
+  event_bus_name = aws_cloudwatch_event_bus_policy.test.event_bus_name
+}
+
+output "policy_sid" {
+  value = jsondecode(data.aws_cloudwatch_event_bus_policy.test.policy).Statement[0].Sid
+}
+`, rName)
+}
+
+func testAccBusPolicyDataSourceNoPolicyConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+
+data "aws_cloudwatch_event_bus_policy" "test" {
+  event_bus_name = aws_cloudwatch_event_bus.test.name
+}
+`, rName)
+}
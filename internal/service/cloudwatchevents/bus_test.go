@@ -1,17 +1,23 @@
 package cloudwatchevents_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/stub"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfcloudwatchevents "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatchevents"
 )
@@ -124,6 +130,74 @@ func TestAccCloudWatchEventsBus_tags(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchEventsBus_DefaultTags_import(t *testing.T) {
+	var providers []*schema.Provider
+	var v events.DescribeEventBusOutput
+	busName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_bus.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, events.EndpointsID),
+		ProviderFactories: acctest.FactoriesInternal(&providers),
+		CheckDestroy:      testAccCheckBusDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccBusConfig_Tags1(busName, "resourcekey1", "resourcevalue1"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventBusExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.resourcekey1", "resourcevalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.resourcekey1", "resourcevalue1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported state, got %d", len(states))
+					}
+
+					attributes := states[0].Attributes
+
+					// Provider default tags must only be present in tags_all.
+					for k, expected := range map[string]string{
+						"tags.%":                "1",
+						"tags.resourcekey1":     "resourcevalue1",
+						"tags_all.%":            "2",
+						"tags_all.providerkey1": "providervalue1",
+						"tags_all.resourcekey1": "resourcevalue1",
+					} {
+						if got := attributes[k]; got != expected {
+							return fmt.Errorf("expected imported %s to be %q, got %q", k, expected, got)
+						}
+					}
+
+					if _, ok := attributes["tags.providerkey1"]; ok {
+						return fmt.Errorf("expected provider default tag providerkey1 not to be in imported tags")
+					}
+
+					return nil
+				},
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccBusConfig_Tags1(busName, "resourcekey1", "resourcevalue1"),
+				),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccCloudWatchEventsBus_default(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -193,6 +267,230 @@ func TestAccCloudWatchEventsBus_partnerEventSource(t *testing.T) {
 	})
 }
 
+func TestBusCreate_partnerEventSourceState(t *testing.T) {
+	const eventSourceName = "aws.partner/example.com/123456789012/test"
+
+	testCases := []struct {
+		Name           string
+		State          string
+		ExpirationTime time.Time
+		ExpectedError  *regexp.Regexp
+	}{
+		{
+			Name:  "active",
+			State: events.EventSourceStateActive,
+		},
+		{
+			Name:           "pending",
+			State:          events.EventSourceStatePending,
+			ExpirationTime: time.Now().Add(24 * time.Hour),
+		},
+		{
+			Name:           "expired",
+			State:          events.EventSourceStatePending,
+			ExpirationTime: time.Now().Add(-24 * time.Hour),
+			ExpectedError:  regexp.MustCompile(`partner event source \(` + regexp.QuoteMeta(eventSourceName) + `\) expired at`),
+		},
+		{
+			Name:          "deleted",
+			State:         events.EventSourceStateDeleted,
+			ExpectedError: regexp.MustCompile(`partner event source \(` + regexp.QuoteMeta(eventSourceName) + `\) has been deleted`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var createCalled bool
+
+			conn := events.New(stub.Session(t))
+			stub.Send(conn.Client, func(r *request.Request) {
+				switch output := r.Data.(type) {
+				case *events.DescribeEventSourceOutput:
+					output.Name = aws.String(eventSourceName)
+					output.State = aws.String(testCase.State)
+
+					if !testCase.ExpirationTime.IsZero() {
+						output.ExpirationTime = aws.Time(testCase.ExpirationTime)
+					}
+				case *events.CreateEventBusOutput:
+					createCalled = true
+
+					output.EventBusArn = aws.String("arn:aws:events:us-west-2:123456789012:event-bus/" + eventSourceName) //lintignore:AWSAT003,AWSAT005
+				case *events.DescribeEventBusOutput:
+					output.Arn = aws.String("arn:aws:events:us-west-2:123456789012:event-bus/" + eventSourceName) //lintignore:AWSAT003,AWSAT005
+					output.Name = aws.String(eventSourceName)
+				}
+			})
+
+			meta := &conns.AWSClient{CloudWatchEventsConn: conn}
+			r := tfcloudwatchevents.ResourceBus()
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"event_source_name": eventSourceName,
+				"name":              eventSourceName,
+			})
+
+			diff, err := r.Diff(context.Background(), nil, config, meta)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			_, diags := r.Apply(context.Background(), nil, diff, meta)
+
+			if testCase.ExpectedError != nil {
+				if !diags.HasError() {
+					t.Fatal("expected error, got none")
+				}
+
+				if got := diags[0].Summary; !testCase.ExpectedError.MatchString(got) {
+					t.Errorf("unexpected error: %s", got)
+				}
+
+				if createCalled {
+					t.Error("expected CreateEventBus not to be called")
+				}
+
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if !createCalled {
+				t.Error("expected CreateEventBus to be called")
+			}
+		})
+	}
+}
+
+func TestBusImport_defaultArchiveEventSource(t *testing.T) {
+	const (
+		busName = "test-bus"
+		busARN  = "arn:aws:events:us-west-2:123456789012:event-bus/" + busName //lintignore:AWSAT003,AWSAT005
+	)
+
+	testCases := []struct {
+		Name                  string
+		ArchiveEventSourceARN string
+		Expected              bool
+	}{
+		{
+			Name:                  "same event bus",
+			ArchiveEventSourceARN: busARN,
+			Expected:              true,
+		},
+		{
+			Name:                  "different event bus",
+			ArchiveEventSourceARN: "arn:aws:events:us-west-2:123456789012:event-bus/other", //lintignore:AWSAT003,AWSAT005
+			Expected:              false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := events.New(stub.Session(t))
+			stub.Send(conn.Client, func(r *request.Request) {
+				switch output := r.Data.(type) {
+				case *events.DescribeEventBusOutput:
+					output.Arn = aws.String(busARN)
+					output.Name = aws.String(busName)
+				case *events.DescribeArchiveOutput:
+					output.ArchiveName = aws.String(busName + "-archive")
+					output.EventSourceArn = aws.String(testCase.ArchiveEventSourceARN)
+				}
+			})
+
+			meta := &conns.AWSClient{CloudWatchEventsConn: conn}
+			r := tfcloudwatchevents.ResourceBus()
+			d := r.TestResourceData()
+			d.SetId(busName)
+
+			if _, err := r.Importer.State(d, meta); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := d.Get("create_default_archive").(bool); got != testCase.Expected {
+				t.Errorf("got create_default_archive %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestBusImport_notFound(t *testing.T) {
+	var operations []string
+
+	conn := events.New(stub.Session(t))
+	stub.Send(conn.Client, func(r *request.Request) {
+		operations = append(operations, r.Operation.Name)
+		r.Error = awserr.New(events.ErrCodeResourceNotFoundException, "Event bus test-bus does not exist.", nil)
+	})
+
+	meta := &conns.AWSClient{CloudWatchEventsConn: conn}
+	r := tfcloudwatchevents.ResourceBus()
+	d := r.TestResourceData()
+	d.SetId("test-bus")
+
+	imported, err := r.Importer.State(d, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(imported) != 1 || imported[0].Id() != "test-bus" {
+		t.Fatalf("expected the event bus to be passed on to the read, got: %v", imported)
+	}
+
+	if got, expected := fmt.Sprint(operations), fmt.Sprint([]string{"DescribeEventBus"}); got != expected {
+		t.Errorf("got operations %s, expected %s", got, expected)
+	}
+}
+
+func TestAccCloudWatchEventsBus_createDefaultArchive(t *testing.T) {
+	var v events.DescribeEventBusOutput
+	busName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_bus.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, events.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBusDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBusCreateDefaultArchiveConfig(busName, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventBusExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "create_default_archive", "true"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "default_archive_arn", "events", fmt.Sprintf("archive/%s-archive", busName)),
+					resource.TestCheckResourceAttr(resourceName, "default_archive_retention_days", "7"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBusCreateDefaultArchiveConfig(busName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventBusExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "create_default_archive", "true"),
+					resource.TestCheckResourceAttr(resourceName, "default_archive_retention_days", "30"),
+				),
+			},
+			{
+				Config: testAccBusConfig(busName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventBusExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "create_default_archive", "false"),
+					resource.TestCheckResourceAttr(resourceName, "default_archive_arn", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBusDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchEventsConn
 
@@ -266,6 +564,17 @@ resource "aws_cloudwatch_event_bus" "test" {
 `, name)
 }
 
+func testAccBusCreateDefaultArchiveConfig(name string, retentionDays int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+
+  create_default_archive         = true
+  default_archive_retention_days = %[2]d
+}
+`, name, retentionDays)
+}
+
 func testAccBusConfig_Tags1(name, key, value string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_bus" "test" {
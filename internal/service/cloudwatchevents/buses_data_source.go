@@ -0,0 +1,100 @@
+package cloudwatchevents
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceBuses() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBusesRead,
+
+		Schema: map[string]*schema.Schema{
+			"event_buses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceBusesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudWatchEventsConn
+
+	var eventBuses []*events.EventBus
+
+	err := ListAllEventBusesPages(conn, d.Get("name_prefix").(string), func(page *events.ListEventBusesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, eventBus := range page.EventBuses {
+			if eventBus == nil {
+				continue
+			}
+
+			eventBuses = append(eventBuses, eventBus)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing EventBridge event buses: %w", err)
+	}
+
+	sort.Slice(eventBuses, func(i, j int) bool {
+		return aws.StringValue(eventBuses[i].Name) < aws.StringValue(eventBuses[j].Name)
+	})
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("event_buses", flattenEventBuses(eventBuses)); err != nil {
+		return fmt.Errorf("error setting event_buses: %w", err)
+	}
+
+	return nil
+}
+
+func flattenEventBuses(apiObjects []*events.EventBus) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"arn":    aws.StringValue(apiObject.Arn),
+			"name":   aws.StringValue(apiObject.Name),
+			"policy": aws.StringValue(apiObject.Policy),
+		})
+	}
+
+	return tfList
+}
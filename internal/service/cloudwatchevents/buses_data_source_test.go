@@ -0,0 +1,52 @@
+package cloudwatchevents_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudWatchEventsBusesDataSource_namePrefix(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_event_buses.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, cloudwatchevents.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBusesDataSourceNamePrefixConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "event_buses.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "event_buses.0.arn", "aws_cloudwatch_event_bus.test1", "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "event_buses.0.name", "aws_cloudwatch_event_bus.test1", "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "event_buses.1.arn", "aws_cloudwatch_event_bus.test2", "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "event_buses.1.name", "aws_cloudwatch_event_bus.test2", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBusesDataSourceNamePrefixConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test2" {
+  name = "%[1]s-2"
+}
+
+resource "aws_cloudwatch_event_bus" "test1" {
+  name = "%[1]s-1"
+}
+
+data "aws_cloudwatch_event_buses" "test" {
+  name_prefix = %[1]q
+
+  depends_on = [aws_cloudwatch_event_bus.test1, aws_cloudwatch_event_bus.test2]
+}
+`, rName)
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
@@ -310,8 +312,12 @@ func resourceConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
 		input.AuthorizationType = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("auth_parameters"); ok {
-		input.AuthParameters = expandUpdateConnectionAuthRequestParameters(v.([]interface{}))
+	// Secrets are write-only and stored by EventBridge in AWS Secrets Manager,
+	// so they are only re-sent when the authorization configuration changes.
+	if d.HasChanges("authorization_type", "auth_parameters") {
+		if v, ok := d.GetOk("auth_parameters"); ok {
+			input.AuthParameters = expandUpdateConnectionAuthRequestParameters(v.([]interface{}))
+		}
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -559,7 +565,13 @@ func flattenConnectionAuthParameters(
 	}
 
 	if authParameters.InvocationHttpParameters != nil {
-		config["invocation_http_parameters"] = flattenConnectionHTTPParameters(authParameters.InvocationHttpParameters, resourceData, "auth_parameters.0.invocation_http_parameters")
+		invocationHTTPParameters := flattenConnectionHTTPParameters(authParameters.InvocationHttpParameters, resourceData, "auth_parameters.0.invocation_http_parameters")
+
+		// Invocation HTTP parameters that were cleared are returned empty rather than omitted.
+		if v := invocationHTTPParameters[0]; len(v["body"].([]map[string]interface{}))+len(v["header"].([]map[string]interface{}))+len(v["query_string"].([]map[string]interface{})) > 0 ||
+			len(resourceData.Get("auth_parameters.0.invocation_http_parameters").([]interface{})) > 0 {
+			config["invocation_http_parameters"] = invocationHTTPParameters
+		}
 	}
 
 	result := []map[string]interface{}{config}
@@ -648,55 +660,95 @@ func flattenConnectionHTTPParameters(
 		return nil
 	}
 
-	var bodyParameters []map[string]interface{}
-	for i, param := range httpParameters.BodyParameters {
-		config := make(map[string]interface{})
-		config["is_value_secret"] = aws.BoolValue(param.IsValueSecret)
-		config["key"] = aws.StringValue(param.Key)
+	var bodyParameters []connectionHTTPParameter
+	for _, param := range httpParameters.BodyParameters {
+		bodyParameters = append(bodyParameters, connectionHTTPParameter{key: aws.StringValue(param.Key), value: param.Value, isValueSecret: aws.BoolValue(param.IsValueSecret)})
+	}
 
-		if param.Value != nil {
-			config["value"] = aws.StringValue(param.Value)
-		} else if v, ok := resourceData.GetOk(fmt.Sprintf("%s.0.body.%d.value", path, i)); ok {
-			config["value"] = v.(string)
-		}
-		bodyParameters = append(bodyParameters, config)
+	var headerParameters []connectionHTTPParameter
+	for _, param := range httpParameters.HeaderParameters {
+		headerParameters = append(headerParameters, connectionHTTPParameter{key: aws.StringValue(param.Key), value: param.Value, isValueSecret: aws.BoolValue(param.IsValueSecret)})
 	}
 
-	var headerParameters []map[string]interface{}
-	for i, param := range httpParameters.HeaderParameters {
-		config := make(map[string]interface{})
-		config["is_value_secret"] = aws.BoolValue(param.IsValueSecret)
-		config["key"] = aws.StringValue(param.Key)
+	var queryStringParameters []connectionHTTPParameter
+	for _, param := range httpParameters.QueryStringParameters {
+		queryStringParameters = append(queryStringParameters, connectionHTTPParameter{key: aws.StringValue(param.Key), value: param.Value, isValueSecret: aws.BoolValue(param.IsValueSecret)})
+	}
+
+	parameters := make(map[string]interface{})
+	parameters["body"] = flattenConnectionHTTPParameterList(bodyParameters, resourceData, path+".0.body", false)
+	parameters["header"] = flattenConnectionHTTPParameterList(headerParameters, resourceData, path+".0.header", true)
+	parameters["query_string"] = flattenConnectionHTTPParameterList(queryStringParameters, resourceData, path+".0.query_string", false)
 
-		if param.Value != nil {
-			config["value"] = aws.StringValue(param.Value)
-		} else if v, ok := resourceData.GetOk(fmt.Sprintf("%s.0.header.%d.value", path, i)); ok {
-			config["value"] = v.(string)
+	result := []map[string]interface{}{parameters}
+	return result
+}
+
+// connectionHTTPParameter is a body, header or query string parameter of a connection.
+type connectionHTTPParameter struct {
+	key           string
+	value         *string
+	isValueSecret bool
+}
+
+// flattenConnectionHTTPParameterList flattens the HTTP parameters returned by DescribeConnection in the order
+// they are configured, followed by any that are not configured. Secret values are not returned by the API and
+// are taken from the configured parameter with the same key. A Content-Type header added by EventBridge is
+// ignored unless it is configured.
+func flattenConnectionHTTPParameterList(params []connectionHTTPParameter, resourceData *schema.ResourceData, path string, ignoreDefaultContentType bool) []map[string]interface{} {
+	configured, _ := resourceData.Get(path).([]interface{})
+	positions := make(map[string]int)
+	values := make(map[string]string)
+
+	for i, v := range configured {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
 		}
-		headerParameters = append(headerParameters, config)
+
+		key := tfMap["key"].(string)
+
+		if _, ok := positions[key]; ok {
+			continue
+		}
+
+		positions[key] = i
+		values[key] = tfMap["value"].(string)
 	}
 
-	var queryStringParameters []map[string]interface{}
-	for i, param := range httpParameters.QueryStringParameters {
+	var result []map[string]interface{}
+	var unconfigured []map[string]interface{}
+
+	for _, param := range params {
+		_, isConfigured := positions[param.key]
+
+		if ignoreDefaultContentType && !isConfigured && strings.EqualFold(param.key, "Content-Type") {
+			continue
+		}
+
 		config := make(map[string]interface{})
-		config["is_value_secret"] = aws.BoolValue(param.IsValueSecret)
-		config["key"] = aws.StringValue(param.Key)
+		config["is_value_secret"] = param.isValueSecret
+		config["key"] = param.key
 
-		if param.Value != nil {
-			config["value"] = aws.StringValue(param.Value)
-		} else if v, ok := resourceData.GetOk(fmt.Sprintf("%s.0.query_string.%d.value", path, i)); ok {
-			config["value"] = v.(string)
+		if param.value != nil {
+			config["value"] = aws.StringValue(param.value)
+		} else if v, ok := values[param.key]; ok {
+			config["value"] = v
+		}
+
+		if isConfigured {
+			result = append(result, config)
+		} else {
+			unconfigured = append(unconfigured, config)
 		}
-		queryStringParameters = append(queryStringParameters, config)
 	}
 
-	parameters := make(map[string]interface{})
-	parameters["body"] = bodyParameters
-	parameters["header"] = headerParameters
-	parameters["query_string"] = queryStringParameters
+	sort.SliceStable(result, func(i, j int) bool {
+		return positions[result[i]["key"].(string)] < positions[result[j]["key"].(string)]
+	})
 
-	result := []map[string]interface{}{parameters}
-	return result
+	return append(result, unconfigured...)
 }
 
 func expandUpdateConnectionAuthRequestParameters(config []interface{}) *events.UpdateConnectionAuthRequestParameters {
@@ -713,7 +765,7 @@ func expandUpdateConnectionAuthRequestParameters(config []interface{}) *events.U
 			authParameters.OAuthParameters = expandUpdateConnectionOAuthAuthRequestParameters(val.([]interface{}))
 		}
 		if val, ok := param["invocation_http_parameters"]; ok {
-			authParameters.InvocationHttpParameters = expandConnectionHTTPParameters(val.([]interface{}))
+			authParameters.InvocationHttpParameters = expandUpdateConnectionHTTPParameters(val.([]interface{}))
 		}
 	}
 
@@ -790,3 +842,28 @@ func expandUpdateConnectionOAuthClientRequestParameters(config []interface{}) *e
 	}
 	return oAuthClientRequestParameters
 }
+
+// expandUpdateConnectionHTTPParameters returns the complete set of invocation HTTP parameters to send when updating
+// a connection. EventBridge merges the parameters of an update into the existing ones, so empty lists are sent
+// for parameter types that are not configured in order to remove any that were configured previously.
+func expandUpdateConnectionHTTPParameters(config []interface{}) *events.ConnectionHttpParameters {
+	httpParameters := expandConnectionHTTPParameters(config)
+
+	if httpParameters == nil {
+		httpParameters = &events.ConnectionHttpParameters{}
+	}
+
+	if httpParameters.BodyParameters == nil {
+		httpParameters.BodyParameters = []*events.ConnectionBodyParameter{}
+	}
+
+	if httpParameters.HeaderParameters == nil {
+		httpParameters.HeaderParameters = []*events.ConnectionHeaderParameter{}
+	}
+
+	if httpParameters.QueryStringParameters == nil {
+		httpParameters.QueryStringParameters = []*events.ConnectionQueryStringParameter{}
+	}
+
+	return httpParameters
+}
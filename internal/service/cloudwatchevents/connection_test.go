@@ -1,22 +1,180 @@
 package cloudwatchevents_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/stub"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfcloudwatchevents "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatchevents"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func TestConnectionUpdate_invocationHTTPParameters(t *testing.T) {
+	testConfig := func(headers ...map[string]interface{}) *terraform.ResourceConfig {
+		var header []interface{}
+		for _, v := range headers {
+			header = append(header, v)
+		}
+
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":               "test",
+			"authorization_type": events.ConnectionAuthorizationTypeApiKey,
+			"auth_parameters": []interface{}{map[string]interface{}{
+				"api_key": []interface{}{map[string]interface{}{
+					"key":   "x-api-key",
+					"value": "secret",
+				}},
+				"invocation_http_parameters": []interface{}{map[string]interface{}{
+					"header": header,
+				}},
+			}},
+		})
+	}
+
+	// EventBridge returns the headers in its own order, with a default Content-Type header and without secret values.
+	current := []*events.ConnectionHeaderParameter{
+		{Key: aws.String("Content-Type"), Value: aws.String("application/json"), IsValueSecret: aws.Bool(false)},
+		{Key: aws.String("b"), Value: aws.String("2"), IsValueSecret: aws.Bool(false)},
+		{Key: aws.String("a"), IsValueSecret: aws.Bool(true)},
+	}
+	var updateInput *events.UpdateConnectionInput
+
+	conn := events.New(stub.Session(t))
+	stub.Send(conn.Client, func(r *request.Request) {
+		switch output := r.Data.(type) {
+		case *events.UpdateConnectionOutput:
+			updateInput = r.Params.(*events.UpdateConnectionInput)
+
+			current = []*events.ConnectionHeaderParameter{
+				{Key: aws.String("Content-Type"), Value: aws.String("application/json"), IsValueSecret: aws.Bool(false)},
+				{Key: aws.String("c"), Value: aws.String("3"), IsValueSecret: aws.Bool(false)},
+				{Key: aws.String("a"), IsValueSecret: aws.Bool(true)},
+			}
+		case *events.DescribeConnectionOutput:
+			output.AuthorizationType = aws.String(events.ConnectionAuthorizationTypeApiKey)
+			output.AuthParameters = &events.ConnectionAuthResponseParameters{
+				ApiKeyAuthParameters: &events.ConnectionApiKeyAuthResponseParameters{
+					ApiKeyName: aws.String("x-api-key"),
+				},
+				InvocationHttpParameters: &events.ConnectionHttpParameters{
+					HeaderParameters: current,
+				},
+			}
+			output.ConnectionArn = aws.String("arn:aws:events:us-west-2:123456789012:connection/test/00000000-0000-0000-0000-000000000000") //lintignore:AWSAT003,AWSAT005
+			output.ConnectionState = aws.String(events.ConnectionStateAuthorized)
+			output.Name = aws.String("test")
+		}
+	})
+
+	meta := &conns.AWSClient{CloudWatchEventsConn: conn}
+	r := tfcloudwatchevents.ResourceConnection()
+
+	config := testConfig(
+		map[string]interface{}{"key": "a", "value": "1", "is_value_secret": true},
+		map[string]interface{}{"key": "b", "value": "2"},
+	)
+
+	diff, err := r.Diff(context.Background(), nil, config, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	state, diags := r.Apply(context.Background(), nil, diff, meta)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	diff, err = r.Diff(context.Background(), state, config, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff != nil && !diff.Empty() {
+		t.Fatalf("expected no diff after create, got: %#v", diff.Attributes)
+	}
+
+	config = testConfig(
+		map[string]interface{}{"key": "a", "value": "1", "is_value_secret": true},
+		map[string]interface{}{"key": "c", "value": "3"},
+	)
+
+	diff, err = r.Diff(context.Background(), state, config, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	state, diags = r.Apply(context.Background(), state, diff, meta)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if updateInput == nil {
+		t.Fatal("expected UpdateConnection call")
+	}
+
+	httpParameters := updateInput.AuthParameters.InvocationHttpParameters
+
+	if httpParameters == nil {
+		t.Fatal("expected invocation HTTP parameters in UpdateConnection")
+	}
+
+	var keys []string
+	for _, v := range httpParameters.HeaderParameters {
+		keys = append(keys, aws.StringValue(v.Key))
+	}
+
+	if got, expected := fmt.Sprint(keys), "[a c]"; got != expected {
+		t.Errorf("got UpdateConnection headers %s, expected %s", got, expected)
+	}
+
+	if httpParameters.BodyParameters == nil || len(httpParameters.BodyParameters) != 0 {
+		t.Errorf("expected empty body parameters in UpdateConnection, got %v", httpParameters.BodyParameters)
+	}
+
+	if httpParameters.QueryStringParameters == nil || len(httpParameters.QueryStringParameters) != 0 {
+		t.Errorf("expected empty query string parameters in UpdateConnection, got %v", httpParameters.QueryStringParameters)
+	}
+
+	for k, expected := range map[string]string{
+		"auth_parameters.0.invocation_http_parameters.0.header.#":                 "2",
+		"auth_parameters.0.invocation_http_parameters.0.header.0.key":             "a",
+		"auth_parameters.0.invocation_http_parameters.0.header.0.value":           "1",
+		"auth_parameters.0.invocation_http_parameters.0.header.0.is_value_secret": "true",
+		"auth_parameters.0.invocation_http_parameters.0.header.1.key":             "c",
+		"auth_parameters.0.invocation_http_parameters.0.header.1.value":           "3",
+	} {
+		if got := state.Attributes[k]; got != expected {
+			t.Errorf("got %s %q, expected %q", k, got, expected)
+		}
+	}
+
+	diff, err = r.Diff(context.Background(), state, config, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff != nil && !diff.Empty() {
+		t.Errorf("expected no diff after update, got: %#v", diff.Attributes)
+	}
+}
+
 func TestAccCloudWatchEventsConnection_apiKey(t *testing.T) {
 	var v1, v2, v3 events.DescribeConnectionOutput
 	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -362,6 +520,63 @@ func TestAccCloudWatchEventsConnection_oAuth(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchEventsConnection_oAuthSecretsUnchanged(t *testing.T) {
+	var v1, v2 events.DescribeConnectionOutput
+	var secretARN string
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	description := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	descriptionModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	clientID := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	clientSecret := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bodyKey := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bodyValue := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	headerKey := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	headerValue := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	queryStringKey := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	queryStringValue := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_connection.oauth"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, events.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionConfig_oauth(name, description, "OAUTH_CLIENT_CREDENTIALS", "https://www.hashicorp.com/products/terraform", "POST", clientID, clientSecret, bodyKey, bodyValue, true, headerKey, headerValue, true, queryStringKey, queryStringValue, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventConnectionExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.client_parameters.0.client_secret", clientSecret),
+					resource.TestCheckResourceAttrSet(resourceName, "secret_arn"),
+					func(s *terraform.State) error {
+						secretARN = s.RootModule().Resources[resourceName].Primary.Attributes["secret_arn"]
+						return nil
+					},
+				),
+			},
+			{
+				Config:   testAccConnectionConfig_oauth(name, description, "OAUTH_CLIENT_CREDENTIALS", "https://www.hashicorp.com/products/terraform", "POST", clientID, clientSecret, bodyKey, bodyValue, true, headerKey, headerValue, true, queryStringKey, queryStringValue, true),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccConnectionConfig_oauth(name, descriptionModified, "OAUTH_CLIENT_CREDENTIALS", "https://www.hashicorp.com/products/terraform", "POST", clientID, clientSecret, bodyKey, bodyValue, true, headerKey, headerValue, true, queryStringKey, queryStringValue, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventConnectionExists(resourceName, &v2),
+					testAccCheckCloudWatchEventConnectionNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "description", descriptionModified),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.client_parameters.0.client_secret", clientSecret),
+					func(s *terraform.State) error {
+						if got := s.RootModule().Resources[resourceName].Primary.Attributes["secret_arn"]; got != secretARN {
+							return fmt.Errorf("secret_arn changed from %s to %s", secretARN, got)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudWatchEventsConnection_invocationHTTPParameters(t *testing.T) {
 	var v1, v2, v3 events.DescribeConnectionOutput
 	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
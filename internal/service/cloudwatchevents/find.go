@@ -37,13 +37,38 @@ func FindConnectionByName(conn *events.CloudWatchEvents, name string) (*events.D
 	return output, nil
 }
 
-func FindRuleByEventBusAndRuleNames(conn *events.CloudWatchEvents, eventBusName, ruleName string) (*events.DescribeRuleOutput, error) {
-	input := events.DescribeRuleInput{
-		Name: aws.String(ruleName),
+func FindEventBusByName(conn *events.CloudWatchEvents, name string) (*events.DescribeEventBusOutput, error) {
+	input := &events.DescribeEventBusInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeEventBus(input)
+
+	if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
 	}
 
-	if eventBusName != "" {
-		input.EventBusName = aws.String(eventBusName)
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindRuleByEventBusAndRuleNames(conn *events.CloudWatchEvents, eventBusName, ruleName string) (*events.DescribeRuleOutput, error) {
+	input := events.DescribeRuleInput{
+		EventBusName: aws.String(eventBusNameOrDefault(eventBusName)),
+		Name:         aws.String(ruleName),
 	}
 
 	output, err := conn.DescribeRule(&input)
@@ -104,3 +129,87 @@ func FindTarget(conn *events.CloudWatchEvents, busName, ruleName, targetId strin
 	}
 	return result, nil
 }
+
+func FindArchiveByName(conn *events.CloudWatchEvents, name string) (*events.DescribeArchiveOutput, error) {
+	input := &events.DescribeArchiveInput{
+		ArchiveName: aws.String(name),
+	}
+
+	output, err := conn.DescribeArchive(input)
+
+	if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindAPIDestinationByName(conn *events.CloudWatchEvents, name string) (*events.DescribeApiDestinationOutput, error) {
+	input := &events.DescribeApiDestinationInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeApiDestination(input)
+
+	if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindEventSourceByName(conn *events.CloudWatchEvents, name string) (*events.DescribeEventSourceOutput, error) {
+	input := &events.DescribeEventSourceInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeEventSource(input)
+
+	if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
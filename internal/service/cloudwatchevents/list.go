@@ -7,13 +7,52 @@ import (
 
 func ListAllTargetsForRulePages(conn *events.CloudWatchEvents, busName, ruleName string, fn func(*events.ListTargetsByRuleOutput, bool) bool) error {
 	input := &events.ListTargetsByRuleInput{
-		Rule:  aws.String(ruleName),
+		EventBusName: eventBusNameOrARN(busName),
+		Rule:         aws.String(ruleName),
+		Limit:        aws.Int64(100), // Set limit to allowed maximum to prevent API throttling
+	}
+
+	return listTargetsByRulePages(conn, input, fn)
+}
+
+func ListAllRulesForBusPages(conn *events.CloudWatchEvents, busName string, fn func(*events.ListRulesOutput, bool) bool) error {
+	input := &events.ListRulesInput{
+		EventBusName: eventBusNameOrARN(busName),
+		Limit:        aws.Int64(100), // Set limit to allowed maximum to prevent API throttling
+	}
+
+	return listRulesPages(conn, input, fn)
+}
+
+func ListAllEventBusesPages(conn *events.CloudWatchEvents, namePrefix string, fn func(*events.ListEventBusesOutput, bool) bool) error {
+	input := &events.ListEventBusesInput{
 		Limit: aws.Int64(100), // Set limit to allowed maximum to prevent API throttling
 	}
 
-	if busName != "" {
-		input.EventBusName = aws.String(busName)
+	if namePrefix != "" {
+		input.NamePrefix = aws.String(namePrefix)
 	}
 
-	return listTargetsByRulePages(conn, input, fn)
+	return listEventBusesPages(conn, input, fn)
+}
+
+// eventBusNameOrARN returns the EventBusName request parameter for the specified event bus name or ARN.
+// The parameter is omitted for the default event bus of the caller's account.
+// ARNs are passed through unchanged as they are required to address event buses in other accounts.
+func eventBusNameOrARN(busName string) *string {
+	if busName == "" || busName == DefaultEventBusName {
+		return nil
+	}
+
+	return aws.String(busName)
+}
+
+// eventBusNameOrDefault returns the specified event bus name or ARN, resolving an empty value to the default event bus.
+// It is used by resource operations that send a concrete event bus name, whereas the list helpers omit the parameter instead.
+func eventBusNameOrDefault(busName string) string {
+	if busName == "" {
+		return DefaultEventBusName
+	}
+
+	return busName
 }
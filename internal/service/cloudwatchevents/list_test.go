@@ -0,0 +1,220 @@
+package cloudwatchevents_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/stub"
+	tfcloudwatchevents "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatchevents"
+)
+
+func TestListAllEventBusesPages(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		NamePrefix         string
+		ExpectedNamePrefix *string
+	}{
+		{
+			Name: "no name prefix",
+		},
+		{
+			Name:               "name prefix",
+			NamePrefix:         "example",
+			ExpectedNamePrefix: aws.String("example"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := testListPagesConn(t, 2, func(r *request.Request, page int) {
+				input := r.Params.(*events.ListEventBusesInput)
+
+				if got, want := aws.StringValue(input.NamePrefix), aws.StringValue(testCase.ExpectedNamePrefix); got != want {
+					t.Errorf("page %d: expected NamePrefix %q, got %q", page, want, got)
+				}
+
+				if (input.NamePrefix == nil) != (testCase.ExpectedNamePrefix == nil) {
+					t.Errorf("page %d: expected NamePrefix set: %t", page, testCase.ExpectedNamePrefix != nil)
+				}
+
+				output := r.Data.(*events.ListEventBusesOutput)
+				output.EventBuses = []*events.EventBus{{Name: aws.String(fmt.Sprintf("bus-%d", page))}}
+				if page < 1 {
+					output.NextToken = aws.String(fmt.Sprintf("token-%d", page))
+				}
+			})
+
+			var names []string
+
+			err := tfcloudwatchevents.ListAllEventBusesPages(conn, testCase.NamePrefix, func(page *events.ListEventBusesOutput, lastPage bool) bool {
+				for _, bus := range page.EventBuses {
+					names = append(names, aws.StringValue(bus.Name))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := len(names), 2; got != want {
+				t.Errorf("expected %d event buses, got %d: %v", want, got, names)
+			}
+		})
+	}
+}
+
+func TestListAllRulesForBusPages(t *testing.T) {
+	testCases := []struct {
+		Name                 string
+		BusName              string
+		ExpectedEventBusName *string
+	}{
+		{
+			Name: "empty",
+		},
+		{
+			Name:    "default event bus name",
+			BusName: "default",
+		},
+		{
+			Name:                 "custom event bus name",
+			BusName:              "example",
+			ExpectedEventBusName: aws.String("example"),
+		},
+		{
+			Name:                 "custom event bus ARN",
+			BusName:              "arn:aws:events:us-east-1:123456789012:event-bus/example",             //lintignore:AWSAT003,AWSAT005
+			ExpectedEventBusName: aws.String("arn:aws:events:us-east-1:123456789012:event-bus/example"), //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name:                 "cross-account default event bus ARN",
+			BusName:              "arn:aws:events:us-east-1:123456789012:event-bus/default",             //lintignore:AWSAT003,AWSAT005
+			ExpectedEventBusName: aws.String("arn:aws:events:us-east-1:123456789012:event-bus/default"), //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := testListPagesConn(t, 3, func(r *request.Request, page int) {
+				input := r.Params.(*events.ListRulesInput)
+
+				if got, want := aws.StringValue(input.EventBusName), aws.StringValue(testCase.ExpectedEventBusName); got != want {
+					t.Errorf("page %d: expected EventBusName %q, got %q", page, want, got)
+				}
+
+				output := r.Data.(*events.ListRulesOutput)
+				output.Rules = []*events.Rule{{Name: aws.String(fmt.Sprintf("rule-%d", page))}}
+				if page < 2 {
+					output.NextToken = aws.String(fmt.Sprintf("token-%d", page))
+				}
+			})
+
+			var names []string
+
+			err := tfcloudwatchevents.ListAllRulesForBusPages(conn, testCase.BusName, func(page *events.ListRulesOutput, lastPage bool) bool {
+				for _, rule := range page.Rules {
+					names = append(names, aws.StringValue(rule.Name))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := len(names), 3; got != want {
+				t.Errorf("expected %d rules, got %d: %v", want, got, names)
+			}
+		})
+	}
+}
+
+func TestListAllTargetsForRulePages(t *testing.T) {
+	testCases := []struct {
+		Name                 string
+		BusName              string
+		ExpectedEventBusName *string
+	}{
+		{
+			Name: "empty",
+		},
+		{
+			Name:    "default event bus name",
+			BusName: "default",
+		},
+		{
+			Name:                 "custom event bus name",
+			BusName:              "example",
+			ExpectedEventBusName: aws.String("example"),
+		},
+		{
+			Name:                 "custom event bus ARN",
+			BusName:              "arn:aws:events:us-east-1:123456789012:event-bus/example",             //lintignore:AWSAT003,AWSAT005
+			ExpectedEventBusName: aws.String("arn:aws:events:us-east-1:123456789012:event-bus/example"), //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := testListPagesConn(t, 2, func(r *request.Request, page int) {
+				input := r.Params.(*events.ListTargetsByRuleInput)
+
+				if got, want := aws.StringValue(input.EventBusName), aws.StringValue(testCase.ExpectedEventBusName); got != want {
+					t.Errorf("page %d: expected EventBusName %q, got %q", page, want, got)
+				}
+
+				if got, want := aws.StringValue(input.Rule), "example-rule"; got != want {
+					t.Errorf("page %d: expected Rule %q, got %q", page, want, got)
+				}
+
+				output := r.Data.(*events.ListTargetsByRuleOutput)
+				output.Targets = []*events.Target{{Id: aws.String(fmt.Sprintf("target-%d", page))}}
+				if page < 1 {
+					output.NextToken = aws.String(fmt.Sprintf("token-%d", page))
+				}
+			})
+
+			var ids []string
+
+			err := tfcloudwatchevents.ListAllTargetsForRulePages(conn, testCase.BusName, "example-rule", func(page *events.ListTargetsByRuleOutput, lastPage bool) bool {
+				for _, target := range page.Targets {
+					ids = append(ids, aws.StringValue(target.Id))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := len(ids), 2; got != want {
+				t.Errorf("expected %d targets, got %d: %v", want, got, ids)
+			}
+		})
+	}
+}
+
+// testListPagesConn returns a client whose requests are answered by the specified handler.
+// The handler is called with the zero-based page number and must populate the response.
+func testListPagesConn(t *testing.T, pages int, handler func(*request.Request, int)) *events.CloudWatchEvents {
+	var page int
+
+	conn := events.New(stub.Session(t))
+	stub.Send(conn.Client, func(r *request.Request) {
+		if page >= pages {
+			t.Fatalf("unexpected request for page %d", page)
+		}
+
+		handler(r, page)
+		page++
+	})
+
+	return conn
+}
@@ -1,6 +1,8 @@
 package cloudwatchevents
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"time"
@@ -8,6 +10,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -22,6 +26,9 @@ import (
 
 const (
 	cloudWatchEventRuleDeleteRetryTimeout = 5 * time.Minute
+
+	// Maximum number of targets per RemoveTargets request
+	ruleRemoveTargetsBatchSize = 100
 )
 
 func ResourceRule() *schema.Resource {
@@ -56,7 +63,6 @@ func ResourceRule() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 256),
-				AtLeastOneOf: []string{"schedule_expression", "event_pattern"},
 			},
 			"event_bus_name": {
 				Type:         schema.TypeString,
@@ -66,15 +72,41 @@ func ResourceRule() *schema.Resource {
 				Default:      DefaultEventBusName,
 			},
 			"event_pattern": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validateEventPatternValue(),
-				AtLeastOneOf: []string{"schedule_expression", "event_pattern"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"event_pattern_detail"},
+				ValidateFunc:  validateEventPatternValue(),
 				StateFunc: func(v interface{}) string {
 					json, _ := structure.NormalizeJsonString(v.(string))
 					return json
 				},
 			},
+			"event_pattern_detail": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"event_pattern"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"detail": {
+							Type:             schema.TypeMap,
+							Optional:         true,
+							Elem:             &schema.Schema{Type: schema.TypeString},
+							DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+						},
+						"detail_type": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"source": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			"description": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -90,6 +122,15 @@ func ResourceRule() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"managed_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -98,7 +139,10 @@ func ResourceRule() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceRuleCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -120,26 +164,7 @@ func resourceRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Creating CloudWatch Events Rule: %s", input)
-	// IAM Roles take some time to propagate
-	err = resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
-		_, err = conn.PutRule(input)
-
-		if tfawserr.ErrMessageContains(err, "ValidationException", "cannot be assumed by principal") {
-			return resource.RetryableError(err)
-		}
-
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
-
-	if tfresource.TimedOut(err) {
-		_, err = conn.PutRule(input)
-	}
-
-	if err != nil {
+	if err := putRule(conn, input); err != nil {
 		return fmt.Errorf("error creating CloudWatch Events Rule (%s): %w", name, err)
 	}
 
@@ -179,7 +204,21 @@ func resourceRuleRead(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return fmt.Errorf("event pattern contains an invalid JSON: %w", err)
 		}
-		d.Set("event_pattern", pattern)
+
+		// Keep the structured representation when it is in use and can still describe the pattern.
+		// Anything else is surfaced as the raw pattern so that the difference shows up in the plan.
+		if tfMap, ok := flattenEventPatternDetail(pattern); ok && len(d.Get("event_pattern_detail").([]interface{})) > 0 {
+			if err := d.Set("event_pattern_detail", []interface{}{tfMap}); err != nil {
+				return fmt.Errorf("error setting event_pattern_detail: %w", err)
+			}
+			d.Set("event_pattern", nil)
+		} else {
+			d.Set("event_pattern", pattern)
+			d.Set("event_pattern_detail", nil)
+		}
+	} else {
+		d.Set("event_pattern", nil)
+		d.Set("event_pattern_detail", nil)
 	}
 	d.Set("name", output.Name)
 	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(output.Name)))
@@ -194,6 +233,7 @@ func resourceRuleRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("is_enabled", enabled)
+	d.Set("managed_by", output.ManagedBy)
 
 	tags, err := ListTags(conn, arn)
 
@@ -230,26 +270,7 @@ func resourceRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	// IAM Roles take some time to propagate
-	err = resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
-		_, err := conn.PutRule(input)
-
-		if tfawserr.ErrMessageContains(err, "ValidationException", "cannot be assumed by principal") {
-			return resource.RetryableError(err)
-		}
-
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
-
-	if tfresource.TimedOut(err) {
-		_, err = conn.PutRule(input)
-	}
-
-	if err != nil {
+	if err := putRule(conn, input); err != nil {
 		return fmt.Errorf("error updating CloudWatch Events Rule (%s): %w", d.Id(), err)
 	}
 
@@ -274,15 +295,61 @@ func resourceRuleDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	input := &events.DeleteRuleInput{
-		Name: aws.String(ruleName),
-	}
-	if eventBusName != "" {
-		input.EventBusName = aws.String(eventBusName)
+	// A rule cannot be deleted while it has targets. By default, wait for targets managed elsewhere to be removed.
+	if d.Get("force_destroy").(bool) {
+		targets, err := findRuleTargets(conn, eventBusName, ruleName)
+
+		if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudWatch Events Rule (%s) targets: %w", d.Id(), err)
+		}
+
+		if err := removeRuleTargets(conn, eventBusName, ruleName, targets); err != nil {
+			return fmt.Errorf("error removing targets from CloudWatch Events Rule (%s): %w", d.Id(), err)
+		}
 	}
 
 	log.Printf("[DEBUG] Deleting CloudWatch Events Rule: %s", d.Id())
-	err = resource.Retry(cloudWatchEventRuleDeleteRetryTimeout, func() *resource.RetryError {
+	if err := deleteRule(conn, eventBusName, ruleName); err != nil {
+		return fmt.Errorf("error deleting CloudWatch Events Rule (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putRule(conn *events.CloudWatchEvents, input *events.PutRuleInput) error {
+	// IAM Roles take some time to propagate
+	err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
+		_, err := conn.PutRule(input)
+
+		if tfawserr.ErrMessageContains(err, "ValidationException", "cannot be assumed by principal") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.PutRule(input)
+	}
+
+	return err
+}
+
+func deleteRule(conn *events.CloudWatchEvents, eventBusName, ruleName string) error {
+	input := &events.DeleteRuleInput{
+		EventBusName: aws.String(eventBusNameOrDefault(eventBusName)),
+		Name:         aws.String(ruleName),
+	}
+
+	err := resource.Retry(cloudWatchEventRuleDeleteRetryTimeout, func() *resource.RetryError {
 		_, err := conn.DeleteRule(input)
 
 		if tfawserr.ErrMessageContains(err, "ValidationException", "Rule can't be deleted since it has targets") {
@@ -304,25 +371,125 @@ func resourceRuleDelete(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	if err != nil {
-		return fmt.Errorf("error deleting CloudWatch Events Rule (%s): %w", d.Id(), err)
+	return err
+}
+
+// findRuleTargets returns all targets of a rule.
+func findRuleTargets(conn *events.CloudWatchEvents, eventBusName, ruleName string) ([]*events.Target, error) {
+	var targets []*events.Target
+
+	err := ListAllTargetsForRulePages(conn, eventBusName, ruleName, func(page *events.ListTargetsByRuleOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		targets = append(targets, page.Targets...)
+
+		return !lastPage
+	})
+
+	return targets, err
+}
+
+// removeRuleTargets removes targets from a rule in batches of the maximum number of targets per request,
+// including targets added by other AWS services on your behalf.
+func removeRuleTargets(conn *events.CloudWatchEvents, eventBusName, ruleName string, targets []*events.Target) error {
+	for i := 0; i < len(targets); i += ruleRemoveTargetsBatchSize {
+		j := i + ruleRemoveTargetsBatchSize
+		if j > len(targets) {
+			j = len(targets)
+		}
+
+		input := &events.RemoveTargetsInput{
+			EventBusName: eventBusNameOrARN(eventBusName),
+			Force:        aws.Bool(true),
+			Rule:         aws.String(ruleName),
+		}
+
+		for _, target := range targets[i:j] {
+			input.Ids = append(input.Ids, target.Id)
+		}
+
+		output, err := conn.RemoveTargets(input)
+
+		if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			continue
+		}
+
+		var errs *multierror.Error
+
+		for _, failedEntry := range output.FailedEntries {
+			if failedEntry == nil {
+				continue
+			}
+
+			errs = multierror.Append(errs, fmt.Errorf("failure entry for target (%s): %s: %s", aws.StringValue(failedEntry.TargetId), aws.StringValue(failedEntry.ErrorCode), aws.StringValue(failedEntry.ErrorMessage)))
+		}
+
+		if err := errs.ErrorOrNil(); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+func resourceRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Rules created by other AWS services on your behalf cannot be modified.
+	if managedBy := diff.Get("managed_by").(string); diff.Id() != "" && managedBy != "" {
+		for _, k := range []string{"description", "event_bus_name", "event_pattern", "event_pattern_detail", "is_enabled", "role_arn", "schedule_expression", "tags"} {
+			if diff.HasChange(k) {
+				return fmt.Errorf("CloudWatch Events Rule (%s) is managed by %s and cannot be modified", diff.Id(), managedBy)
+			}
+		}
+	}
+
+	// Values that are not yet known (e.g. interpolated from other resources) are validated at apply time by the API.
+	for _, k := range []string{"event_bus_name", "event_pattern", "event_pattern_detail", "event_pattern_detail.0.detail", "event_pattern_detail.0.detail_type", "event_pattern_detail.0.source", "schedule_expression"} {
+		if !diff.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	eventPattern := diff.Get("event_pattern").(string)
+
+	if v, ok := diff.Get("event_pattern_detail").([]interface{}); ok && len(v) > 0 {
+		pattern, err := expandEventPatternDetail(v)
+
+		if err != nil {
+			return err
+		}
+
+		if _, errs := validateEventPatternValue()(pattern, "event_pattern_detail"); len(errs) > 0 {
+			return errs[0]
+		}
+
+		eventPattern = pattern
+	}
+
+	return validateRuleEventPatternOrScheduleExpression(
+		diff.Get("event_bus_name").(string),
+		eventPattern,
+		diff.Get("schedule_expression").(string),
+	)
+}
+
 func buildPutRuleInputStruct(d *schema.ResourceData, name string) (*events.PutRuleInput, error) {
 	input := events.PutRuleInput{
 		Name: aws.String(name),
 	}
-	var eventBusName string
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
-	if v, ok := d.GetOk("event_bus_name"); ok {
-		eventBusName = v.(string)
-		input.EventBusName = aws.String(eventBusName)
-	}
+	input.EventBusName = aws.String(eventBusNameOrDefault(d.Get("event_bus_name").(string)))
 	if v, ok := d.GetOk("event_pattern"); ok {
 		pattern, err := structure.NormalizeJsonString(v)
 		if err != nil {
@@ -330,6 +497,13 @@ func buildPutRuleInputStruct(d *schema.ResourceData, name string) (*events.PutRu
 		}
 		input.EventPattern = aws.String(pattern)
 	}
+	if v, ok := d.GetOk("event_pattern_detail"); ok {
+		pattern, err := expandEventPatternDetail(v.([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		input.EventPattern = aws.String(pattern)
+	}
 	if v, ok := d.GetOk("role_arn"); ok {
 		input.RoleArn = aws.String(v.(string))
 	}
@@ -355,10 +529,120 @@ func validateEventPatternValue() schema.SchemaValidateFunc {
 		}
 
 		// Check whether the normalized JSON is within the given length.
-		const maxJsonLength = 2048
+		const maxJsonLength = 4096
 		if len(json) > maxJsonLength {
-			errors = append(errors, fmt.Errorf("%q cannot be longer than %d characters: %q", k, maxJsonLength, json))
+			errors = append(errors, fmt.Errorf("%q cannot be longer than %d bytes after normalization, got %d bytes", k, maxJsonLength, len(json)))
+		}
+
+		if err := validateEventPatternContentFilters(json); err != nil {
+			errors = append(errors, fmt.Errorf("%q contains an invalid event pattern: %w", k, err))
 		}
 		return
 	}
 }
+
+// expandEventPatternDetail compiles the structured event_pattern_detail block into the
+// equivalent normalized event pattern JSON.
+func expandEventPatternDetail(tfList []interface{}) (string, error) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return "", fmt.Errorf("`event_pattern_detail` must specify at least one of `source`, `detail_type` or `detail`")
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	pattern := make(map[string]interface{})
+
+	if v, ok := tfMap["source"].([]interface{}); ok && len(v) > 0 {
+		pattern["source"] = v
+	}
+
+	if v, ok := tfMap["detail_type"].([]interface{}); ok && len(v) > 0 {
+		pattern["detail-type"] = v
+	}
+
+	if v, ok := tfMap["detail"].(map[string]interface{}); ok && len(v) > 0 {
+		detail := make(map[string]interface{}, len(v))
+
+		for field, v := range v {
+			var matcher interface{}
+
+			if err := json.Unmarshal([]byte(v.(string)), &matcher); err != nil {
+				return "", fmt.Errorf("`event_pattern_detail` detail field %q contains an invalid JSON: %w", field, err)
+			}
+
+			detail[field] = matcher
+		}
+
+		pattern["detail"] = detail
+	}
+
+	if len(pattern) == 0 {
+		return "", fmt.Errorf("`event_pattern_detail` must specify at least one of `source`, `detail_type` or `detail`")
+	}
+
+	b, err := json.Marshal(pattern)
+
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(string(b))
+}
+
+// flattenEventPatternDetail returns the event_pattern_detail representation of an event pattern.
+// The boolean result is false if the pattern uses fields that the structured form cannot represent.
+func flattenEventPatternDetail(pattern string) (map[string]interface{}, bool) {
+	var fields map[string]interface{}
+
+	if err := json.Unmarshal([]byte(pattern), &fields); err != nil {
+		return nil, false
+	}
+
+	tfMap := map[string]interface{}{}
+
+	for field, v := range fields {
+		switch field {
+		case "source", "detail-type":
+			values, ok := v.([]interface{})
+
+			if !ok {
+				return nil, false
+			}
+
+			for _, value := range values {
+				if _, ok := value.(string); !ok {
+					return nil, false
+				}
+			}
+
+			if field == "source" {
+				tfMap["source"] = values
+			} else {
+				tfMap["detail_type"] = values
+			}
+		case "detail":
+			matchers, ok := v.(map[string]interface{})
+
+			if !ok {
+				return nil, false
+			}
+
+			detail := make(map[string]interface{}, len(matchers))
+
+			for field, matcher := range matchers {
+				b, err := json.Marshal(matcher)
+
+				if err != nil {
+					return nil, false
+				}
+
+				detail[field] = string(b)
+			}
+
+			tfMap["detail"] = detail
+		default:
+			return nil, false
+		}
+	}
+
+	return tfMap, true
+}
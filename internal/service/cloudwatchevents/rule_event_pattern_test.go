@@ -0,0 +1,188 @@
+package cloudwatchevents
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+func TestExpandEventPatternDetail(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Input         []interface{}
+		Expected      string
+		ExpectedError bool
+	}{
+		{
+			Name:          "no block",
+			ExpectedError: true,
+		},
+		{
+			Name:          "empty block",
+			Input:         []interface{}{nil},
+			ExpectedError: true,
+		},
+		{
+			Name: "empty fields",
+			Input: []interface{}{map[string]interface{}{
+				"detail":      map[string]interface{}{},
+				"detail_type": []interface{}{},
+				"source":      []interface{}{},
+			}},
+			ExpectedError: true,
+		},
+		{
+			Name: "source",
+			Input: []interface{}{map[string]interface{}{
+				"source": []interface{}{"aws.ec2"},
+			}},
+			Expected: `{"source": ["aws.ec2"]}`,
+		},
+		{
+			Name: "source and detail type",
+			Input: []interface{}{map[string]interface{}{
+				"detail_type": []interface{}{"AWS Console Sign In via CloudTrail"},
+				"source":      []interface{}{"aws.signin", "aws.console"},
+			}},
+			Expected: `{"detail-type": ["AWS Console Sign In via CloudTrail"], "source": ["aws.signin", "aws.console"]}`,
+		},
+		{
+			Name: "detail",
+			Input: []interface{}{map[string]interface{}{
+				"detail": map[string]interface{}{
+					"state":         `["running", "stopped"]`,
+					"instance-type": `[{"prefix": "t3."}]`,
+					"tags":          `{"Environment": ["production"]}`,
+				},
+				"detail_type": []interface{}{"EC2 Instance State-change Notification"},
+				"source":      []interface{}{"aws.ec2"},
+			}},
+			Expected: `{
+  "source": ["aws.ec2"],
+  "detail-type": ["EC2 Instance State-change Notification"],
+  "detail": {
+    "state": ["running", "stopped"],
+    "instance-type": [{"prefix": "t3."}],
+    "tags": {"Environment": ["production"]}
+  }
+}`,
+		},
+		{
+			Name: "invalid detail JSON",
+			Input: []interface{}{map[string]interface{}{
+				"detail": map[string]interface{}{
+					"state": "running",
+				},
+			}},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := expandEventPatternDetail(testCase.Input)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatalf("expected error, got pattern: %s", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			expected, err := structure.NormalizeJsonString(testCase.Expected)
+
+			if err != nil {
+				t.Fatalf("error normalizing expected pattern: %s", err)
+			}
+
+			if got != expected {
+				t.Errorf("got %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestFlattenEventPatternDetail(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Pattern    string
+		Expected   map[string]interface{}
+		ExpectedOK bool
+	}{
+		{
+			Name:       "invalid JSON",
+			Pattern:    `{`,
+			ExpectedOK: false,
+		},
+		{
+			Name:       "unsupported field",
+			Pattern:    `{"source": ["aws.ec2"], "account": ["123456789012"]}`,
+			ExpectedOK: false,
+		},
+		{
+			Name:       "content filter in source",
+			Pattern:    `{"source": [{"prefix": "aws."}]}`,
+			ExpectedOK: false,
+		},
+		{
+			Name:    "source and detail type",
+			Pattern: `{"detail-type": ["AWS API Call via CloudTrail"], "source": ["aws.s3"]}`,
+			Expected: map[string]interface{}{
+				"detail_type": []interface{}{"AWS API Call via CloudTrail"},
+				"source":      []interface{}{"aws.s3"},
+			},
+			ExpectedOK: true,
+		},
+		{
+			Name:    "detail",
+			Pattern: `{"detail": {"state": ["running"], "tags": {"Environment": [{"prefix": "prod"}]}}}`,
+			Expected: map[string]interface{}{
+				"detail": map[string]interface{}{
+					"state": `["running"]`,
+					"tags":  `{"Environment":[{"prefix":"prod"}]}`,
+				},
+			},
+			ExpectedOK: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, ok := flattenEventPatternDetail(testCase.Pattern)
+
+			if ok != testCase.ExpectedOK {
+				t.Fatalf("got ok %t, expected %t", ok, testCase.ExpectedOK)
+			}
+
+			if !ok {
+				return
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+
+			pattern, err := expandEventPatternDetail([]interface{}{got})
+
+			if err != nil {
+				t.Fatalf("unexpected error expanding flattened pattern: %s", err)
+			}
+
+			expected, err := structure.NormalizeJsonString(testCase.Pattern)
+
+			if err != nil {
+				t.Fatalf("error normalizing pattern: %s", err)
+			}
+
+			if pattern != expected {
+				t.Errorf("round trip got %s, expected %s", pattern, expected)
+			}
+		})
+	}
+}
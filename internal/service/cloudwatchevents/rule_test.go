@@ -1,17 +1,21 @@
 package cloudwatchevents_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/stub"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tfcloudwatchevents "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatchevents"
@@ -56,19 +60,22 @@ func TestAccCloudWatchEventsRule_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "role_arn", ""),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 					resource.TestCheckResourceAttr(resourceName, "is_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "managed_by", ""),
 					testAccCheckCloudWatchEventRuleEnabled(resourceName, "ENABLED"),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateIdFunc: testAccRuleNoBusNameImportStateIdFunc(resourceName),
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccRuleNoBusNameImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
 			},
 			{
 				Config: testAccRuleConfig(rName2),
@@ -123,9 +130,10 @@ func TestAccCloudWatchEventsRule_eventBusName(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
 			},
 			{
 				Config: testAccRuleEventBusNameConfig(rName, busName, "description 2"),
@@ -172,9 +180,10 @@ func TestAccCloudWatchEventsRule_role(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
 			},
 		},
 	})
@@ -200,9 +209,10 @@ func TestAccCloudWatchEventsRule_description(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
 			},
 			{
 				Config: testAccRuleDescriptionConfig(rName, "description2"),
@@ -216,6 +226,106 @@ func TestAccCloudWatchEventsRule_description(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchEventsRule_managedBy(t *testing.T) {
+	key := "AWS_CLOUDWATCH_EVENTS_MANAGED_RULE_NAME"
+	ruleName := os.Getenv(key)
+	if ruleName == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	resourceName := "aws_cloudwatch_event_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, events.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:        testAccRuleManagedByConfig(ruleName),
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: ruleName,
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					if len(s) != 1 {
+						return fmt.Errorf("expected 1 state: %#v", s)
+					}
+
+					if v := s[0].Attributes["managed_by"]; v == "" {
+						return fmt.Errorf("expected managed_by to be set for CloudWatch Events Rule (%s)", s[0].ID)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestRuleCustomizeDiff_managedBy(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		ManagedBy   string
+		Description string
+		ExpectError bool
+	}{
+		{
+			Name:        "customer managed rule modified",
+			Description: "updated",
+		},
+		{
+			Name:        "service managed rule unchanged",
+			ManagedBy:   "states.amazonaws.com",
+			Description: "original",
+		},
+		{
+			Name:        "service managed rule modified",
+			ManagedBy:   "states.amazonaws.com",
+			Description: "updated",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "default/test",
+				Attributes: map[string]string{
+					"arn":                 "arn:aws:events:us-west-2:123456789012:rule/test", //lintignore:AWSAT003,AWSAT005
+					"description":         "original",
+					"event_bus_name":      "default",
+					"id":                  "default/test",
+					"is_enabled":          "true",
+					"managed_by":          testCase.ManagedBy,
+					"name":                "test",
+					"name_prefix":         "",
+					"schedule_expression": "rate(1 hour)",
+					"tags.%":              "0",
+					"tags_all.%":          "0",
+				},
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"description":         testCase.Description,
+				"name":                "test",
+				"schedule_expression": "rate(1 hour)",
+			})
+
+			_, err := tfcloudwatchevents.ResourceRule().Diff(context.Background(), state, config, &conns.AWSClient{})
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				if !regexp.MustCompile(`is managed by states\.amazonaws\.com and cannot be modified`).MatchString(err.Error()) {
+					t.Errorf("unexpected error: %s", err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestAccCloudWatchEventsRule_pattern(t *testing.T) {
 	var v1, v2 events.DescribeRuleOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -237,9 +347,10 @@ func TestAccCloudWatchEventsRule_pattern(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
 			},
 			{
 				Config: testAccRulePatternConfig(rName, "{\"source\":[\"aws.lambda\"]}"),
@@ -253,8 +364,8 @@ func TestAccCloudWatchEventsRule_pattern(t *testing.T) {
 	})
 }
 
-func TestAccCloudWatchEventsRule_scheduleAndPattern(t *testing.T) {
-	var v events.DescribeRuleOutput
+func TestAccCloudWatchEventsRule_eventPatternDetail(t *testing.T) {
+	var v1, v2 events.DescribeRuleOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudwatch_event_rule.test"
 
@@ -265,18 +376,309 @@ func TestAccCloudWatchEventsRule_scheduleAndPattern(t *testing.T) {
 		CheckDestroy: testAccCheckRuleDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccRuleScheduleAndPatternConfig(rName, "{\"source\":[\"aws.ec2\"]}"),
+				Config: testAccRuleEventPatternDetailConfig(rName, "running"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCloudWatchEventRuleExists(resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					testAccCheckCloudWatchEventRuleExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "event_pattern", ""),
+					resource.TestCheckResourceAttr(resourceName, "event_pattern_detail.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_pattern_detail.0.source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_pattern_detail.0.source.0", "aws.ec2"),
+					resource.TestCheckResourceAttr(resourceName, "event_pattern_detail.0.detail_type.0", "EC2 Instance State-change Notification"),
+					resource.TestCheckResourceAttr(resourceName, "event_pattern_detail.0.detail.%", "1"),
+					testAccCheckRuleEventPattern(&v1, `{"detail":{"state":["running"]},"detail-type":["EC2 Instance State-change Notification"],"source":["aws.ec2"]}`),
+				),
+			},
+			{
+				Config: testAccRuleEventPatternDetailConfig(rName, "stopped"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventRuleExists(resourceName, &v2),
+					testAccCheckCloudWatchEventRuleNotRecreated(&v1, &v2),
+					testAccCheckRuleEventPattern(&v2, `{"detail":{"state":["stopped"]},"detail-type":["EC2 Instance State-change Notification"],"source":["aws.ec2"]}`),
+				),
+			},
+			{
+				Config:      testAccRuleEventPatternAndDetailConfig(rName),
+				ExpectError: regexp.MustCompile(`"event_pattern_detail": conflicts with event_pattern`),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchEventsRule_updateKeepsTargets(t *testing.T) {
+	var v1, v2, v3 events.DescribeRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, events.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleWithTargetPatternConfig(rName, "aws.ec2", "first", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventRuleExists(resourceName, &v1),
+					testAccCheckRuleTargetCount(resourceName, 1),
+				),
+			},
+			{
+				Config: testAccRuleWithTargetPatternConfig(rName, "aws.lambda", "second", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventRuleExists(resourceName, &v2),
+					testAccCheckCloudWatchEventRuleNotRecreated(&v1, &v2),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "event_pattern", "{\"source\":[\"aws.lambda\"]}"),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+					resource.TestCheckResourceAttr(resourceName, "is_enabled", "false"),
+					testAccCheckRuleTargetCount(resourceName, 1),
+				),
+			},
+			{
+				Config: testAccRuleWithTargetScheduleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventRuleExists(resourceName, &v3),
+					testAccCheckCloudWatchEventRuleNotRecreated(&v1, &v3),
+					resource.TestCheckResourceAttr(resourceName, "event_pattern", ""),
 					resource.TestCheckResourceAttr(resourceName, "schedule_expression", "rate(1 hour)"),
-					acctest.CheckResourceAttrEquivalentJSON(resourceName, "event_pattern", "{\"source\":[\"aws.ec2\"]}"),
+					testAccCheckRuleTargetCount(resourceName, 1),
 				),
 			},
+		},
+	})
+}
+
+func TestRuleUpdate_targetsUntouched(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "default/test",
+		Attributes: map[string]string{
+			"arn":            "arn:aws:events:us-west-2:123456789012:rule/test", //lintignore:AWSAT003,AWSAT005
+			"description":    "original",
+			"event_bus_name": "default",
+			"event_pattern":  `{"source":["aws.ec2"]}`,
+			"id":             "default/test",
+			"is_enabled":     "true",
+			"name":           "test",
+			"name_prefix":    "",
+			"tags.%":         "0",
+			"tags_all.%":     "0",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"description":   "updated",
+		"event_pattern": `{"source":["aws.lambda"]}`,
+		"is_enabled":    false,
+		"name":          "test",
+	})
+
+	var operations []string
+
+	conn := events.New(stub.Session(t))
+	stub.Send(conn.Client, func(r *request.Request) {
+		operations = append(operations, r.Operation.Name)
+
+		if output, ok := r.Data.(*events.DescribeRuleOutput); ok {
+			output.Arn = aws.String(state.Attributes["arn"])
+			output.Description = aws.String("updated")
+			output.EventBusName = aws.String("default")
+			output.EventPattern = aws.String(`{"source":["aws.lambda"]}`)
+			output.Name = aws.String("test")
+			output.State = aws.String(events.RuleStateDisabled)
+		}
+	})
+
+	meta := &conns.AWSClient{CloudWatchEventsConn: conn}
+	r := tfcloudwatchevents.ResourceRule()
+
+	diff, err := r.Diff(context.Background(), state, config, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff.RequiresNew() {
+		t.Fatal("expected in-place update, got replacement")
+	}
+
+	newState, diags := r.Apply(context.Background(), state, diff, meta)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, expected := newState.Attributes["description"], "updated"; got != expected {
+		t.Errorf("got description %s, expected %s", got, expected)
+	}
+
+	var putRule bool
+
+	for _, operation := range operations {
+		switch operation {
+		case "PutRule":
+			putRule = true
+		case "DeleteRule", "ListTargetsByRule", "PutTargets", "RemoveTargets":
+			t.Errorf("unexpected %s call during in-place update", operation)
+		}
+	}
+
+	if !putRule {
+		t.Errorf("expected PutRule call, got %v", operations)
+	}
+}
+
+func TestRuleDelete_removesTargets(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		ForceDestroy       string
+		FailedEntries      []*events.RemoveTargetsResultEntry
+		ExpectedOperations []string
+		ExpectedRemoved    int
+		ExpectedError      *regexp.Regexp
+	}{
+		{
+			Name:               "basic",
+			ForceDestroy:       "false",
+			ExpectedOperations: []string{"DeleteRule"},
+		},
+		{
+			Name:               "force destroy",
+			ForceDestroy:       "true",
+			ExpectedOperations: []string{"ListTargetsByRule", "ListTargetsByRule", "RemoveTargets", "RemoveTargets", "DeleteRule"},
+			ExpectedRemoved:    120,
+		},
+		{
+			Name:         "failed entries",
+			ForceDestroy: "true",
+			FailedEntries: []*events.RemoveTargetsResultEntry{
+				{
+					ErrorCode:    aws.String("ManagedRuleException"),
+					ErrorMessage: aws.String("managed target"),
+					TargetId:     aws.String("target-7"),
+				},
+				{
+					ErrorCode:    aws.String("ManagedRuleException"),
+					ErrorMessage: aws.String("managed target"),
+					TargetId:     aws.String("target-42"),
+				},
+			},
+			ExpectedError: regexp.MustCompile(`target-7(.|\n)*target-42`),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "default/test",
+				Attributes: map[string]string{
+					"event_bus_name": "default",
+					"force_destroy":  testCase.ForceDestroy,
+					"id":             "default/test",
+					"name":           "test",
+				},
+			}
+
+			var operations []string
+			var removedIDs []string
+
+			conn := events.New(stub.Session(t))
+			stub.Send(conn.Client, func(r *request.Request) {
+				operations = append(operations, r.Operation.Name)
+
+				switch input := r.Params.(type) {
+				case *events.ListTargetsByRuleInput:
+					output := r.Data.(*events.ListTargetsByRuleOutput)
+
+					// 120 targets in pages of 100.
+					start, end := 0, 100
+					if aws.StringValue(input.NextToken) != "" {
+						start, end = 100, 120
+					} else {
+						output.NextToken = aws.String("token")
+					}
+
+					for i := start; i < end; i++ {
+						output.Targets = append(output.Targets, &events.Target{Id: aws.String(fmt.Sprintf("target-%d", i))})
+					}
+				case *events.RemoveTargetsInput:
+					if !aws.BoolValue(input.Force) {
+						t.Error("expected Force to be set")
+					}
+
+					if got, max := len(input.Ids), 100; got > max {
+						t.Errorf("got %d target IDs in RemoveTargets request, expected at most %d", got, max)
+					}
+
+					removedIDs = append(removedIDs, aws.StringValueSlice(input.Ids)...)
+
+					r.Data.(*events.RemoveTargetsOutput).FailedEntries = testCase.FailedEntries
+				}
+			})
+
+			meta := &conns.AWSClient{CloudWatchEventsConn: conn}
+			r := tfcloudwatchevents.ResourceRule()
+
+			_, diags := r.Apply(context.Background(), state, &terraform.InstanceDiff{Destroy: true}, meta)
+
+			if testCase.ExpectedError != nil {
+				if !diags.HasError() {
+					t.Fatalf("expected error matching %s, got none", testCase.ExpectedError)
+				}
+
+				if got := diags[0].Summary; !testCase.ExpectedError.MatchString(got) {
+					t.Fatalf("expected error matching %s, got %s", testCase.ExpectedError, got)
+				}
+
+				for _, operation := range operations {
+					if operation == "DeleteRule" {
+						t.Error("unexpected DeleteRule call after failed RemoveTargets")
+					}
+				}
+
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, expected := len(removedIDs), testCase.ExpectedRemoved; got != expected {
+				t.Errorf("got %d removed targets, expected %d", got, expected)
+			}
+
+			if got, expected := fmt.Sprint(operations), fmt.Sprint(testCase.ExpectedOperations); got != expected {
+				t.Errorf("got operations %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestAccCloudWatchEventsRule_scheduleAndPattern(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, events.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRuleDestroy,
+		Steps: []resource.TestStep{
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				Config:      testAccRuleScheduleAndPatternConfig(rName, "{\"source\":[\"aws.ec2\"]}"),
+				ExpectError: regexp.MustCompile("only one of `event_pattern` or `schedule_expression` can be specified"),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchEventsRule_scheduleCustomEventBus(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, events.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleScheduleCustomEventBusConfig(rName),
+				ExpectError: regexp.MustCompile("`schedule_expression` can only be used with the default event bus"),
 			},
 		},
 	})
@@ -302,9 +704,10 @@ func TestAccCloudWatchEventsRule_namePrefix(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
 			},
 		},
 	})
@@ -329,14 +732,131 @@ func TestAccCloudWatchEventsRule_Name_generated(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchEventsRule_Name_both(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, events.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleNameBothConfig(rName),
+				ExpectError: regexp.MustCompile(`"name(_prefix)?": conflicts with name`),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchEventsRule_Name_prefixToName(t *testing.T) {
+	var v1, v2 events.DescribeRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, events.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleNamePrefixConfig("tf-acc-test-prefix-"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventRuleExists(resourceName, &v1),
+					create.TestCheckResourceAttrNameFromPrefix(resourceName, "name", "tf-acc-test-prefix-"),
+				),
+			},
+			{
+				Config:   testAccRuleNamePrefixConfig("tf-acc-test-prefix-"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventRuleExists(resourceName, &v2),
+					testAccCheckCloudWatchEventRuleRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
 			},
 		},
 	})
 }
 
+func TestRuleValidate_nameOrNamePrefix(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Config        map[string]interface{}
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			Name: "name",
+			Config: map[string]interface{}{
+				"name": "test",
+			},
+		},
+		{
+			Name: "name_prefix",
+			Config: map[string]interface{}{
+				"name_prefix": "test-",
+			},
+		},
+		{
+			Name:   "neither",
+			Config: map[string]interface{}{},
+		},
+		{
+			Name: "both",
+			Config: map[string]interface{}{
+				"name":        "test",
+				"name_prefix": "test-",
+			},
+			ExpectedError: regexp.MustCompile(`"name(_prefix)?": conflicts with name`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Config["schedule_expression"] = "rate(5 minutes)"
+
+			diags := tfcloudwatchevents.ResourceRule().Validate(terraform.NewResourceConfigRaw(testCase.Config))
+
+			if testCase.ExpectedError == nil {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+
+				return
+			}
+
+			if !diags.HasError() {
+				t.Fatal("expected error, got none")
+			}
+
+			var matched bool
+
+			for _, d := range diags {
+				if testCase.ExpectedError.MatchString(d.Detail) {
+					matched = true
+				}
+			}
+
+			if !matched {
+				t.Errorf("expected error matching %s, got: %v", testCase.ExpectedError, diags)
+			}
+		})
+	}
+}
+
 func TestAccCloudWatchEventsRule_tags(t *testing.T) {
 	var v1, v2, v3 events.DescribeRuleOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -357,9 +877,10 @@ func TestAccCloudWatchEventsRule_tags(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
 			},
 			{
 				Config: testAccRuleTags2Config(rName, "key1", "value1updated", "key2", "value2"),
@@ -409,9 +930,10 @@ func TestAccCloudWatchEventsRule_isEnabled(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
 			},
 			{
 				Config: testAccRuleIsEnabledConfig(rName, true),
@@ -466,9 +988,10 @@ func TestAccCloudWatchEventsRule_partnerEventBus(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
 			},
 		},
 	})
@@ -502,9 +1025,10 @@ func TestAccCloudWatchEventsRule_eventBusARN(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
 			},
 		},
 	})
@@ -535,6 +1059,28 @@ func testAccCheckCloudWatchEventRuleExists(n string, rule *events.DescribeRuleOu
 	}
 }
 
+func testAccCheckRuleEventPattern(rule *events.DescribeRuleOutput, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		got, err := structure.NormalizeJsonString(aws.StringValue(rule.EventPattern))
+
+		if err != nil {
+			return err
+		}
+
+		expected, err := structure.NormalizeJsonString(expected)
+
+		if err != nil {
+			return err
+		}
+
+		if got != expected {
+			return fmt.Errorf("CloudWatch Events Rule event pattern: got %s, expected %s", got, expected)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckCloudWatchEventRuleEnabled(n string, desired string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -582,6 +1128,39 @@ func testAccCheckRuleDestroy(s *terraform.State) error {
 	return nil
 }
 
+func testAccCheckRuleTargetCount(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchEventsConn
+
+		var count int
+
+		err := tfcloudwatchevents.ListAllTargetsForRulePages(conn, rs.Primary.Attributes["event_bus_name"], rs.Primary.Attributes["name"], func(page *events.ListTargetsByRuleOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			count += len(page.Targets)
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if count != expected {
+			return fmt.Errorf("CloudWatch Events Rule (%s) has %d targets, expected %d", rs.Primary.ID, count, expected)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckCloudWatchEventRuleRecreated(i, j *events.DescribeRuleOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if aws.StringValue(i.Arn) == aws.StringValue(j.Arn) {
@@ -620,6 +1199,15 @@ resource "aws_cloudwatch_event_rule" "test" {
 `, name)
 }
 
+func testAccRuleManagedByConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_rule" "test" {
+  name          = %[1]q
+  event_pattern = jsonencode({ source = ["aws.ecs"] })
+}
+`, name)
+}
+
 func testAccRuleDefaultEventBusNameConfig(name string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_rule" "test" {
@@ -662,6 +1250,79 @@ PATTERN
 `, name, pattern)
 }
 
+func testAccRuleEventPatternDetailConfig(name, state string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_rule" "test" {
+  name = %[1]q
+
+  event_pattern_detail {
+    source      = ["aws.ec2"]
+    detail_type = ["EC2 Instance State-change Notification"]
+
+    detail = {
+      state = jsonencode([%[2]q])
+    }
+  }
+}
+`, name, state)
+}
+
+func testAccRuleEventPatternAndDetailConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_rule" "test" {
+  name = %[1]q
+
+  event_pattern = jsonencode({
+    source = ["aws.ec2"]
+  })
+
+  event_pattern_detail {
+    source = ["aws.ec2"]
+  }
+}
+`, name)
+}
+
+func testAccRuleWithTargetPatternConfig(name, source, description string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_rule" "test" {
+  name          = %[1]q
+  description   = %[3]q
+  is_enabled    = %[4]t
+  event_pattern = jsonencode({
+    source = [%[2]q]
+  })
+}
+
+resource "aws_cloudwatch_event_target" "test" {
+  rule = aws_cloudwatch_event_rule.test.name
+  arn  = aws_sns_topic.test.arn
+}
+`, name, source, description, enabled)
+}
+
+func testAccRuleWithTargetScheduleConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_rule" "test" {
+  name                = %[1]q
+  schedule_expression = "rate(1 hour)"
+}
+
+resource "aws_cloudwatch_event_target" "test" {
+  rule = aws_cloudwatch_event_rule.test.name
+  arn  = aws_sns_topic.test.arn
+}
+`, name)
+}
+
 func testAccRuleScheduleAndPatternConfig(name, pattern string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_rule" "test" {
@@ -674,6 +1335,20 @@ PATTERN
 `, name, pattern)
 }
 
+func testAccRuleScheduleCustomEventBusConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_rule" "test" {
+  name                = %[1]q
+  event_bus_name      = aws_cloudwatch_event_bus.test.name
+  schedule_expression = "rate(1 hour)"
+}
+`, name)
+}
+
 func testAccRuleDescriptionConfig(name, description string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_rule" "test" {
@@ -709,6 +1384,16 @@ resource "aws_cloudwatch_event_rule" "test" {
 }
 `
 
+func testAccRuleNameBothConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_rule" "test" {
+  name                = %[1]q
+  name_prefix         = "tf-acc-test-prefix-"
+  schedule_expression = "rate(5 minutes)"
+}
+`, name)
+}
+
 func testAccRuleTags1Config(name, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_rule" "test" {
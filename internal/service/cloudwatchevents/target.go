@@ -5,8 +5,10 @@ import (
 	"log"
 	"math"
 	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -72,8 +74,9 @@ func ResourceTarget() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"input_path", "input_transformer"},
+				ValidateFunc:  validateTargetInput,
 				// We could be normalizing the JSON here,
-				// but for built-in targets input may not be JSON
+				// but the exact text is passed to the target
 			},
 
 			"input_path": {
@@ -109,9 +112,10 @@ func ResourceTarget() *schema.Resource {
 			},
 
 			"http_target": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"batch_target", "ecs_target", "kinesis_target", "redshift_target", "sqs_target"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"header_parameters": {
@@ -134,9 +138,10 @@ func ResourceTarget() *schema.Resource {
 			},
 
 			"ecs_target": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"batch_target", "http_target", "kinesis_target", "redshift_target", "sqs_target"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"enable_ecs_managed_tags": {
@@ -205,6 +210,25 @@ func ResourceTarget() *schema.Resource {
 								},
 							},
 						},
+						"placement_strategy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 5,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 255),
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(events.PlacementStrategyType_Values(), false),
+									},
+								},
+							},
+						},
 						"platform_version": {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -233,9 +257,10 @@ func ResourceTarget() *schema.Resource {
 			},
 
 			"batch_target": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"ecs_target", "http_target", "kinesis_target", "redshift_target", "sqs_target"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"job_definition": {
@@ -261,24 +286,26 @@ func ResourceTarget() *schema.Resource {
 			},
 
 			"kinesis_target": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"batch_target", "ecs_target", "http_target", "redshift_target", "sqs_target"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"partition_key_path": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validation.StringLenBetween(1, 256),
+							ValidateFunc: validTargetKinesisPartitionKeyPath,
 						},
 					},
 				},
 			},
 
 			"redshift_target": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"batch_target", "ecs_target", "http_target", "kinesis_target", "sqs_target"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"database": {
@@ -310,9 +337,10 @@ func ResourceTarget() *schema.Resource {
 			},
 
 			"sqs_target": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"batch_target", "ecs_target", "http_target", "kinesis_target", "redshift_target"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"message_group_id": {
@@ -357,11 +385,14 @@ func ResourceTarget() *schema.Resource {
 						"maximum_event_age_in_seconds": {
 							Type:         schema.TypeInt,
 							Optional:     true,
+							Computed:     true,
 							ValidateFunc: validation.IntAtLeast(60),
 						},
 						"maximum_retry_attempts": {
-							Type:     schema.TypeInt,
-							Optional: true,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 185),
 						},
 					},
 				},
@@ -385,6 +416,56 @@ func ResourceTarget() *schema.Resource {
 	}
 }
 
+// checkTargetHTTPPathParameters returns an error if an API destination target does not specify a path parameter value
+// for each path parameter placeholder (*) in the API destination's invocation endpoint.
+// The check is made at apply time, once any change to the API destination has been applied.
+// path_parameter_values is a set, so repeated values are collapsed and fewer values than placeholders are left to the API to reject.
+func checkTargetHTTPPathParameters(conn *events.CloudWatchEvents, d *schema.ResourceData) error {
+	name, ok := apiDestinationNameFromARN(d.Get("arn").(string))
+
+	if !ok {
+		return nil
+	}
+
+	output, err := FindAPIDestinationByName(conn, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Events API Destination (%s): %w", name, err)
+	}
+
+	endpoint := aws.StringValue(output.InvocationEndpoint)
+	placeholders := strings.Count(endpoint, "*")
+	values := 0
+
+	if v, ok := d.Get("http_target.0.path_parameter_values").(*schema.Set); ok {
+		values = v.Len()
+	}
+
+	if values > placeholders || (values == 0 && placeholders > 0) {
+		return fmt.Errorf("http_target.0.path_parameter_values: CloudWatch Events API Destination (%s) invocation endpoint (%s) has %d path parameter placeholders, got %d values", name, endpoint, placeholders, values)
+	}
+
+	return nil
+}
+
+// apiDestinationNameFromARN returns the name of the API destination with the specified ARN,
+// or false if the ARN is not an API destination ARN.
+func apiDestinationNameFromARN(v string) (string, bool) {
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil || parsedARN.Service != events.ServiceName {
+		return "", false
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	if len(parts) < 2 || parts[0] != "api-destination" || parts[1] == "" {
+		return "", false
+	}
+
+	return parts[1], true
+}
+
 func resourceTargetCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CloudWatchEventsConn
 
@@ -397,9 +478,10 @@ func resourceTargetCreate(d *schema.ResourceData, meta interface{}) error {
 		targetID = resource.UniqueId()
 		d.Set("target_id", targetID)
 	}
-	var busName string
-	if v, ok := d.GetOk("event_bus_name"); ok {
-		busName = v.(string)
+	busName := eventBusNameOrDefault(d.Get("event_bus_name").(string))
+
+	if err := checkTargetHTTPPathParameters(conn, d); err != nil {
+		return fmt.Errorf("Creating CloudWatch Events Target failed: %w", err)
 	}
 
 	input := buildPutTargetInputStruct(d)
@@ -425,7 +507,7 @@ func resourceTargetCreate(d *schema.ResourceData, meta interface{}) error {
 func resourceTargetRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CloudWatchEventsConn
 
-	busName := d.Get("event_bus_name").(string)
+	busName := eventBusNameOrDefault(d.Get("event_bus_name").(string))
 
 	t, err := FindTarget(conn, busName, d.Get("rule").(string), d.Get("target_id").(string))
 	if err != nil {
@@ -451,6 +533,8 @@ func resourceTargetRead(d *schema.ResourceData, meta interface{}) error {
 		if err := d.Set("run_command_targets", flattenTargetRunParameters(t.RunCommandParameters)); err != nil {
 			return fmt.Errorf("Error setting run_command_targets error: %w", err)
 		}
+	} else {
+		d.Set("run_command_targets", nil)
 	}
 
 	if t.HttpParameters != nil {
@@ -465,48 +549,64 @@ func resourceTargetRead(d *schema.ResourceData, meta interface{}) error {
 		if err := d.Set("redshift_target", flattenTargetRedshiftParameters(t.RedshiftDataParameters)); err != nil {
 			return fmt.Errorf("Error setting ecs_target error: %w", err)
 		}
+	} else {
+		d.Set("redshift_target", nil)
 	}
 
 	if t.EcsParameters != nil {
 		if err := d.Set("ecs_target", flattenTargetECSParameters(t.EcsParameters)); err != nil {
 			return fmt.Errorf("Error setting ecs_target error: %w", err)
 		}
+	} else {
+		d.Set("ecs_target", nil)
 	}
 
 	if t.BatchParameters != nil {
 		if err := d.Set("batch_target", flattenTargetBatchParameters(t.BatchParameters)); err != nil {
 			return fmt.Errorf("Error setting batch_target error: %w", err)
 		}
+	} else {
+		d.Set("batch_target", nil)
 	}
 
 	if t.KinesisParameters != nil {
 		if err := d.Set("kinesis_target", flattenTargetKinesisParameters(t.KinesisParameters)); err != nil {
 			return fmt.Errorf("Error setting kinesis_target error: %w", err)
 		}
+	} else {
+		d.Set("kinesis_target", nil)
 	}
 
 	if t.SqsParameters != nil {
 		if err := d.Set("sqs_target", flattenTargetSQSParameters(t.SqsParameters)); err != nil {
 			return fmt.Errorf("Error setting sqs_target error: %w", err)
 		}
+	} else {
+		d.Set("sqs_target", nil)
 	}
 
 	if t.InputTransformer != nil {
 		if err := d.Set("input_transformer", flattenCloudWatchInputTransformer(t.InputTransformer)); err != nil {
 			return fmt.Errorf("Error setting input_transformer error: %w", err)
 		}
+	} else {
+		d.Set("input_transformer", nil)
 	}
 
 	if t.RetryPolicy != nil {
 		if err := d.Set("retry_policy", flattenTargetRetryPolicy(t.RetryPolicy)); err != nil {
-			return fmt.Errorf("Error setting retry_policy error: #{err}")
+			return fmt.Errorf("Error setting retry_policy error: %w", err)
 		}
+	} else {
+		d.Set("retry_policy", nil)
 	}
 
-	if t.DeadLetterConfig != nil {
+	if t.DeadLetterConfig != nil && t.DeadLetterConfig.Arn != nil {
 		if err := d.Set("dead_letter_config", flattenTargetDeadLetterConfig(t.DeadLetterConfig)); err != nil {
-			return fmt.Errorf("Error setting dead_letter_config error: #{err}")
+			return fmt.Errorf("Error setting dead_letter_config error: %w", err)
 		}
+	} else {
+		d.Set("dead_letter_config", nil)
 	}
 
 	return nil
@@ -515,6 +615,12 @@ func resourceTargetRead(d *schema.ResourceData, meta interface{}) error {
 func resourceTargetUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CloudWatchEventsConn
 
+	if d.HasChanges("arn", "http_target") {
+		if err := checkTargetHTTPPathParameters(conn, d); err != nil {
+			return fmt.Errorf("error updating CloudWatch Events Target (%s): %w", d.Id(), err)
+		}
+	}
+
 	input := buildPutTargetInputStruct(d)
 
 	log.Printf("[DEBUG] Updating CloudWatch Events Target: %s", input)
@@ -530,12 +636,9 @@ func resourceTargetDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CloudWatchEventsConn
 
 	input := &events.RemoveTargetsInput{
-		Ids:  []*string{aws.String(d.Get("target_id").(string))},
-		Rule: aws.String(d.Get("rule").(string)),
-	}
-
-	if v, ok := d.GetOk("event_bus_name"); ok {
-		input.EventBusName = aws.String(v.(string))
+		EventBusName: aws.String(eventBusNameOrDefault(d.Get("event_bus_name").(string))),
+		Ids:          []*string{aws.String(d.Get("target_id").(string))},
+		Rule:         aws.String(d.Get("rule").(string)),
 	}
 
 	output, err := conn.RemoveTargets(input)
@@ -605,6 +708,12 @@ func buildPutTargetInputStruct(d *schema.ResourceData) *events.PutTargetsInput {
 
 	if v, ok := d.GetOk("retry_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		e.RetryPolicy = expandRetryPolicyParameters(v.([]interface{}))
+
+		// A maximum_retry_attempts of 0 disables retries. As the attribute is Computed,
+		// it is unknown rather than 0 when not configured for a new retry policy.
+		if v, ok := d.GetOkExists("retry_policy.0.maximum_retry_attempts"); ok {
+			e.RetryPolicy.MaximumRetryAttempts = aws.Int64(int64(v.(int)))
+		}
 	}
 
 	if v, ok := d.GetOk("dead_letter_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -612,11 +721,9 @@ func buildPutTargetInputStruct(d *schema.ResourceData) *events.PutTargetsInput {
 	}
 
 	input := events.PutTargetsInput{
-		Rule:    aws.String(d.Get("rule").(string)),
-		Targets: []*events.Target{e},
-	}
-	if v, ok := d.GetOk("event_bus_name"); ok {
-		input.EventBusName = aws.String(v.(string))
+		EventBusName: aws.String(eventBusNameOrDefault(d.Get("event_bus_name").(string))),
+		Rule:         aws.String(d.Get("rule").(string)),
+		Targets:      []*events.Target{e},
 	}
 
 	return &input
@@ -694,6 +801,10 @@ func expandTargetECSParameters(config []interface{}) *events.EcsParameters {
 			ecsParameters.PlacementConstraints = expandTargetPlacementConstraints(v.List())
 		}
 
+		if v, ok := param["placement_strategy"].([]interface{}); ok && len(v) > 0 {
+			ecsParameters.PlacementStrategy = expandTargetPlacementStrategies(v)
+		}
+
 		if v, ok := param["propagate_tags"].(string); ok {
 			ecsParameters.PropagateTags = aws.String(v)
 		}
@@ -717,13 +828,9 @@ func expandRetryPolicyParameters(rp []interface{}) *events.RetryPolicy {
 	for _, v := range rp {
 		params := v.(map[string]interface{})
 
-		if val, ok := params["maximum_event_age_in_seconds"].(int); ok {
+		if val, ok := params["maximum_event_age_in_seconds"].(int); ok && val != 0 {
 			retryPolicy.MaximumEventAgeInSeconds = aws.Int64(int64(val))
 		}
-
-		if val, ok := params["maximum_retry_attempts"].(int); ok {
-			retryPolicy.MaximumRetryAttempts = aws.Int64(int64(val))
-		}
 	}
 
 	return retryPolicy
@@ -887,6 +994,10 @@ func flattenTargetECSParameters(ecsParameters *events.EcsParameters) []map[strin
 		config["placement_constraint"] = flattenTargetPlacementConstraints(ecsParameters.PlacementConstraints)
 	}
 
+	if ecsParameters.PlacementStrategy != nil {
+		config["placement_strategy"] = flattenTargetPlacementStrategies(ecsParameters.PlacementStrategy)
+	}
+
 	config["tags"] = KeyValueTags(ecsParameters.Tags).IgnoreAWS().Map()
 	config["enable_execute_command"] = aws.BoolValue(ecsParameters.EnableExecuteCommand)
 	config["enable_ecs_managed_tags"] = aws.BoolValue(ecsParameters.EnableECSManagedTags)
@@ -915,7 +1026,7 @@ func flattenTargetRedshiftParameters(redshiftParameters *events.RedshiftDataPara
 }
 
 func flattenTargetECSParametersNetworkConfiguration(nc *events.NetworkConfiguration) []interface{} {
-	if nc == nil {
+	if nc == nil || nc.AwsvpcConfiguration == nil {
 		return nil
 	}
 
@@ -996,8 +1107,13 @@ func flattenCloudWatchInputTransformer(inputTransformer *events.InputTransformer
 func flattenTargetRetryPolicy(rp *events.RetryPolicy) []map[string]interface{} {
 	config := make(map[string]interface{})
 
-	config["maximum_event_age_in_seconds"] = aws.Int64Value(rp.MaximumEventAgeInSeconds)
-	config["maximum_retry_attempts"] = aws.Int64Value(rp.MaximumRetryAttempts)
+	if rp.MaximumEventAgeInSeconds != nil {
+		config["maximum_event_age_in_seconds"] = aws.Int64Value(rp.MaximumEventAgeInSeconds)
+	}
+
+	if rp.MaximumRetryAttempts != nil {
+		config["maximum_retry_attempts"] = aws.Int64Value(rp.MaximumRetryAttempts)
+	}
 
 	result := []map[string]interface{}{config}
 	return result
@@ -1059,6 +1175,53 @@ func flattenTargetPlacementConstraints(pcs []*events.PlacementConstraint) []map[
 	return results
 }
 
+func expandTargetPlacementStrategies(tfList []interface{}) []*events.PlacementStrategy {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var result []*events.PlacementStrategy
+
+	for _, tfMapRaw := range tfList {
+		if tfMapRaw == nil {
+			continue
+		}
+
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		apiObject := &events.PlacementStrategy{}
+
+		if v, ok := tfMap["field"].(string); ok && v != "" {
+			apiObject.Field = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		result = append(result, apiObject)
+	}
+
+	return result
+}
+
+func flattenTargetPlacementStrategies(pss []*events.PlacementStrategy) []map[string]interface{} {
+	if len(pss) == 0 {
+		return nil
+	}
+	results := make([]map[string]interface{}, 0)
+	for _, ps := range pss {
+		c := make(map[string]interface{})
+		c["type"] = aws.StringValue(ps.Type)
+		if ps.Field != nil {
+			c["field"] = aws.StringValue(ps.Field)
+		}
+
+		results = append(results, c)
+	}
+	return results
+}
+
 func resourceTargetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	busName, ruleName, targetID, err := TargetParseImportID(d.Id())
 	if err != nil {
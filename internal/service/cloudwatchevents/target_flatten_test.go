@@ -0,0 +1,662 @@
+package cloudwatchevents
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/stub"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	testTargetRuleName = "test-rule"
+	testTargetID       = "test-target"
+)
+
+func TestResourceTargetReadStable(t *testing.T) {
+	testCases := []struct {
+		Name string
+		// Variants are equivalent representations of the same target, as returned by AWS
+		// with unordered collections in different orders.
+		Variants []*events.Target
+		Expected map[string]string
+	}{
+		{
+			Name: "sqs",
+			Variants: []*events.Target{
+				{
+					Arn: aws.String("arn:aws:sqs:us-west-2:123456789012:test.fifo"),
+					Id:  aws.String(testTargetID),
+					SqsParameters: &events.SqsParameters{
+						MessageGroupId: aws.String("group1"),
+					},
+				},
+			},
+			Expected: map[string]string{
+				"sqs_target.#":                  "1",
+				"sqs_target.0.message_group_id": "group1",
+			},
+		},
+		{
+			Name: "kinesis",
+			Variants: []*events.Target{
+				{
+					Arn: aws.String("arn:aws:kinesis:us-west-2:123456789012:stream/test"),
+					Id:  aws.String(testTargetID),
+					KinesisParameters: &events.KinesisParameters{
+						PartitionKeyPath: aws.String("$.detail"),
+					},
+				},
+			},
+			Expected: map[string]string{
+				"kinesis_target.#":                    "1",
+				"kinesis_target.0.partition_key_path": "$.detail",
+			},
+		},
+		{
+			Name: "ecs",
+			Variants: []*events.Target{
+				testTargetECS([]string{"subnet-1", "subnet-2"}, []string{"sg-1", "sg-2"}, []*events.PlacementConstraint{
+					{Type: aws.String(events.PlacementConstraintTypeDistinctInstance)},
+					{Type: aws.String(events.PlacementConstraintTypeMemberOf), Expression: aws.String("attribute:ecs.availability-zone in [us-west-2a]")},
+				}),
+				testTargetECS([]string{"subnet-2", "subnet-1"}, []string{"sg-2", "sg-1"}, []*events.PlacementConstraint{
+					{Type: aws.String(events.PlacementConstraintTypeMemberOf), Expression: aws.String("attribute:ecs.availability-zone in [us-west-2a]")},
+					{Type: aws.String(events.PlacementConstraintTypeDistinctInstance)},
+				}),
+			},
+			Expected: map[string]string{
+				"ecs_target.#":                                           "1",
+				"ecs_target.0.network_configuration.#":                   "1",
+				"ecs_target.0.network_configuration.0.security_groups.#": "2",
+				"ecs_target.0.network_configuration.0.subnets.#":         "2",
+				"ecs_target.0.placement_constraint.#":                    "2",
+				"ecs_target.0.task_count":                                "1",
+			},
+		},
+		{
+			Name: "http",
+			Variants: []*events.Target{
+				testTargetHTTP([]string{"a", "b"}),
+				testTargetHTTP([]string{"b", "a"}),
+			},
+			Expected: map[string]string{
+				"http_target.#":                           "1",
+				"http_target.0.header_parameters.%":       "2",
+				"http_target.0.path_parameter_values.#":   "2",
+				"http_target.0.query_string_parameters.%": "2",
+			},
+		},
+	}
+
+	others := []*events.Target{
+		{
+			Arn: aws.String("arn:aws:sqs:us-west-2:123456789012:other"),
+			Id:  aws.String("other-1"),
+		},
+		{
+			Arn: aws.String("arn:aws:kinesis:us-west-2:123456789012:stream/other"),
+			Id:  aws.String("other-2"),
+			KinesisParameters: &events.KinesisParameters{
+				PartitionKeyPath: aws.String("$.other"),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var want map[string]string
+
+			for i, target := range testCase.Variants {
+				for _, targets := range testTargetOrderings(target, others) {
+					for _, pageSize := range []int{1, 2, len(targets)} {
+						got := testTargetReadState(t, testTargetPages(targets, pageSize))
+
+						if want == nil {
+							want = got
+
+							for k, v := range testCase.Expected {
+								if got[k] != v {
+									t.Errorf("attribute %s = %q, want %q", k, got[k], v)
+								}
+							}
+
+							for _, k := range []string{"ecs_target.#", "http_target.#", "kinesis_target.#", "retry_policy.#", "sqs_target.#"} {
+								if _, ok := testCase.Expected[k]; !ok && got[k] != "" && got[k] != "0" {
+									t.Errorf("attribute %s = %q, want empty", k, got[k])
+								}
+							}
+
+							continue
+						}
+
+						if !reflect.DeepEqual(got, want) {
+							t.Errorf("variant %d with page size %d: state differs\ngot:  %v\nwant: %v", i, pageSize, got, want)
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+// testTargetOrderings returns the target list orderings to feed through ListTargetsByRule.
+func testTargetOrderings(target *events.Target, others []*events.Target) [][]*events.Target {
+	var orderings [][]*events.Target
+
+	for i := 0; i <= len(others); i++ {
+		var targets []*events.Target
+		targets = append(targets, others[:i]...)
+		targets = append(targets, target)
+		targets = append(targets, others[i:]...)
+
+		orderings = append(orderings, targets)
+	}
+
+	return orderings
+}
+
+func testTargetPages(targets []*events.Target, pageSize int) [][]*events.Target {
+	var pages [][]*events.Target
+
+	for i := 0; i < len(targets); i += pageSize {
+		end := i + pageSize
+		if end > len(targets) {
+			end = len(targets)
+		}
+
+		pages = append(pages, targets[i:end])
+	}
+
+	return pages
+}
+
+// testTargetConn returns a client whose ListTargetsByRule responses are served from the given pages.
+func testTargetConn(t *testing.T, pages [][]*events.Target) *events.CloudWatchEvents {
+	conn := events.New(stub.Session(t))
+	stub.Send(conn.Client, func(r *request.Request) {
+		input := r.Params.(*events.ListTargetsByRuleInput)
+		output := r.Data.(*events.ListTargetsByRuleOutput)
+
+		var page int
+
+		if v := aws.StringValue(input.NextToken); v != "" {
+			var err error
+			page, err = strconv.Atoi(v)
+
+			if err != nil {
+				r.Error = err
+				return
+			}
+		}
+
+		output.Targets = pages[page]
+
+		if page+1 < len(pages) {
+			output.NextToken = aws.String(strconv.Itoa(page + 1))
+		}
+	})
+
+	return conn
+}
+
+// testTargetReadState reads the test target from a stubbed paginated ListTargetsByRule response.
+// The resource data starts out with every target type block set so that stale values are detected.
+func testTargetReadState(t *testing.T, pages [][]*events.Target) map[string]string {
+	conn := testTargetConn(t, pages)
+
+	d := schema.TestResourceDataRaw(t, ResourceTarget().Schema, map[string]interface{}{
+		"arn":       "arn:aws:sqs:us-west-2:123456789012:stale",
+		"rule":      testTargetRuleName,
+		"target_id": testTargetID,
+		"kinesis_target": []interface{}{map[string]interface{}{
+			"partition_key_path": "$.stale",
+		}},
+		"retry_policy": []interface{}{map[string]interface{}{
+			"maximum_event_age_in_seconds": 60,
+			"maximum_retry_attempts":       5,
+		}},
+		"sqs_target": []interface{}{map[string]interface{}{
+			"message_group_id": "stale",
+		}},
+	})
+	d.SetId(testTargetRuleName + "-" + testTargetID)
+
+	if err := resourceTargetRead(d, &conns.AWSClient{CloudWatchEventsConn: conn}); err != nil {
+		t.Fatalf("error reading target: %s", err)
+	}
+
+	return d.State().Attributes
+}
+
+func TestTargetECSParametersPlacementRoundTrip(t *testing.T) {
+	ecsTarget := []interface{}{map[string]interface{}{
+		"launch_type": events.LaunchTypeEc2,
+		"placement_constraint": []interface{}{
+			map[string]interface{}{
+				"type": events.PlacementConstraintTypeDistinctInstance,
+			},
+			map[string]interface{}{
+				"expression": "attribute:ecs.availability-zone in [us-west-2a, us-west-2b]",
+				"type":       events.PlacementConstraintTypeMemberOf,
+			},
+		},
+		"placement_strategy": []interface{}{
+			map[string]interface{}{
+				"field": "attribute:ecs.availability-zone",
+				"type":  events.PlacementStrategyTypeSpread,
+			},
+			map[string]interface{}{
+				"field": "memory",
+				"type":  events.PlacementStrategyTypeBinpack,
+			},
+			map[string]interface{}{
+				"type": events.PlacementStrategyTypeRandom,
+			},
+		},
+		"task_definition_arn": "arn:aws:ecs:us-west-2:123456789012:task-definition/test:1",
+	}}
+
+	d := schema.TestResourceDataRaw(t, ResourceTarget().Schema, map[string]interface{}{
+		"arn":        "arn:aws:ecs:us-west-2:123456789012:cluster/test",
+		"rule":       testTargetRuleName,
+		"ecs_target": ecsTarget,
+	})
+
+	ecsParameters := expandTargetECSParameters(d.Get("ecs_target").([]interface{}))
+
+	expectedStrategies := []*events.PlacementStrategy{
+		{Field: aws.String("attribute:ecs.availability-zone"), Type: aws.String(events.PlacementStrategyTypeSpread)},
+		{Field: aws.String("memory"), Type: aws.String(events.PlacementStrategyTypeBinpack)},
+		{Type: aws.String(events.PlacementStrategyTypeRandom)},
+	}
+
+	if !reflect.DeepEqual(ecsParameters.PlacementStrategy, expectedStrategies) {
+		t.Errorf("got placement strategies %s, expected %s", ecsParameters.PlacementStrategy, expectedStrategies)
+	}
+
+	if got, expected := len(ecsParameters.PlacementConstraints), 2; got != expected {
+		t.Errorf("got %d placement constraints, expected %d", got, expected)
+	}
+
+	roundTripped := schema.TestResourceDataRaw(t, ResourceTarget().Schema, map[string]interface{}{})
+
+	if err := roundTripped.Set("ecs_target", flattenTargetECSParameters(ecsParameters)); err != nil {
+		t.Fatalf("error setting ecs_target: %s", err)
+	}
+
+	if got, expected := roundTripped.Get("ecs_target.0.placement_constraint").(*schema.Set), d.Get("ecs_target.0.placement_constraint").(*schema.Set); !got.Equal(expected) {
+		t.Errorf("placement_constraint: got %v, expected %v", got.List(), expected.List())
+	}
+
+	if got, expected := roundTripped.Get("ecs_target.0.placement_strategy"), d.Get("ecs_target.0.placement_strategy"); !reflect.DeepEqual(got, expected) {
+		t.Errorf("placement_strategy: got %v, expected %v", got, expected)
+	}
+}
+
+func testTargetECS(subnets, securityGroups []string, placementConstraints []*events.PlacementConstraint) *events.Target {
+	return &events.Target{
+		Arn: aws.String("arn:aws:ecs:us-west-2:123456789012:cluster/test"),
+		Id:  aws.String(testTargetID),
+		EcsParameters: &events.EcsParameters{
+			LaunchType: aws.String(events.LaunchTypeFargate),
+			NetworkConfiguration: &events.NetworkConfiguration{
+				AwsvpcConfiguration: &events.AwsVpcConfiguration{
+					SecurityGroups: aws.StringSlice(securityGroups),
+					Subnets:        aws.StringSlice(subnets),
+				},
+			},
+			PlacementConstraints: placementConstraints,
+			TaskCount:            aws.Int64(1),
+			TaskDefinitionArn:    aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/test:1"),
+		},
+	}
+}
+
+func testTargetHTTP(keys []string) *events.Target {
+	headers := map[string]*string{}
+	queryStrings := map[string]*string{}
+	var pathParameterValues []*string
+
+	for _, k := range keys {
+		headers["X-"+k] = aws.String(k)
+		queryStrings[k] = aws.String(k)
+		pathParameterValues = append(pathParameterValues, aws.String(k))
+	}
+
+	return &events.Target{
+		Arn: aws.String("arn:aws:execute-api:us-west-2:123456789012:test/test/GET/*/*"),
+		Id:  aws.String(testTargetID),
+		HttpParameters: &events.HttpParameters{
+			HeaderParameters:      headers,
+			PathParameterValues:   pathParameterValues,
+			QueryStringParameters: queryStrings,
+		},
+	}
+}
+
+func TestTargetRetryPolicyMaximumRetryAttempts(t *testing.T) {
+	testCases := []struct {
+		Name                         string
+		RetryPolicy                  map[string]interface{}
+		ExpectedMaximumRetryAttempts *int64
+	}{
+		{
+			Name: "zero",
+			RetryPolicy: map[string]interface{}{
+				"maximum_event_age_in_seconds": 60,
+				"maximum_retry_attempts":       0,
+			},
+			ExpectedMaximumRetryAttempts: aws.Int64(0),
+		},
+		{
+			Name: "non-zero",
+			RetryPolicy: map[string]interface{}{
+				"maximum_event_age_in_seconds": 60,
+				"maximum_retry_attempts":       5,
+			},
+			ExpectedMaximumRetryAttempts: aws.Int64(5),
+		},
+		{
+			Name: "unset",
+			RetryPolicy: map[string]interface{}{
+				"maximum_event_age_in_seconds": 60,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceTarget().Schema, map[string]interface{}{
+				"arn":          "arn:aws:sqs:us-west-2:123456789012:test",
+				"rule":         testTargetRuleName,
+				"target_id":    testTargetID,
+				"retry_policy": []interface{}{testCase.RetryPolicy},
+			})
+
+			input := buildPutTargetInputStruct(d)
+			retryPolicy := input.Targets[0].RetryPolicy
+
+			if retryPolicy == nil {
+				t.Fatal("expected retry policy")
+			}
+
+			if got, expected := retryPolicy.MaximumRetryAttempts, testCase.ExpectedMaximumRetryAttempts; !reflect.DeepEqual(got, expected) {
+				t.Fatalf("got MaximumRetryAttempts %v, expected %v", aws.Int64Value(got), aws.Int64Value(expected))
+			}
+
+			roundTripped := schema.TestResourceDataRaw(t, ResourceTarget().Schema, map[string]interface{}{})
+			roundTripped.SetId(testTargetRuleName + "-" + testTargetID)
+
+			if err := roundTripped.Set("retry_policy", flattenTargetRetryPolicy(retryPolicy)); err != nil {
+				t.Fatalf("error setting retry_policy: %s", err)
+			}
+
+			if got, expected := roundTripped.Get("retry_policy"), d.Get("retry_policy"); !reflect.DeepEqual(got, expected) {
+				t.Errorf("retry_policy: got %v, expected %v", got, expected)
+			}
+
+			if testCase.ExpectedMaximumRetryAttempts != nil {
+				if got := roundTripped.State().Attributes["retry_policy.0.maximum_retry_attempts"]; got != strconv.FormatInt(*testCase.ExpectedMaximumRetryAttempts, 10) {
+					t.Errorf("got maximum_retry_attempts %q in state, expected %d", got, *testCase.ExpectedMaximumRetryAttempts)
+				}
+			}
+		})
+	}
+}
+
+func TestTargetUpdate_retryPolicyMaximumRetryAttemptsPriorState(t *testing.T) {
+	const arn = "arn:aws:sqs:us-west-2:123456789012:test"
+
+	var putTargetsInput *events.PutTargetsInput
+
+	conn := events.New(stub.Session(t))
+	stub.Send(conn.Client, func(r *request.Request) {
+		switch output := r.Data.(type) {
+		case *events.PutTargetsOutput:
+			putTargetsInput = r.Params.(*events.PutTargetsInput)
+		case *events.ListTargetsByRuleOutput:
+			output.Targets = []*events.Target{{
+				Arn:   aws.String(arn),
+				Id:    aws.String(testTargetID),
+				Input: aws.String(`{"key":"value"}`),
+				RetryPolicy: &events.RetryPolicy{
+					MaximumEventAgeInSeconds: aws.Int64(60),
+					MaximumRetryAttempts:     aws.Int64(0),
+				},
+			}}
+		}
+	})
+
+	meta := &conns.AWSClient{CloudWatchEventsConn: conn}
+	r := ResourceTarget()
+
+	// Earlier versions of the provider always sent maximum_retry_attempts, recording 0 when it was not configured.
+	state := &terraform.InstanceState{
+		ID: testTargetRuleName + "-" + testTargetID,
+		Attributes: map[string]string{
+			"arn":            arn,
+			"event_bus_name": "default",
+			"rule":           testTargetRuleName,
+			"target_id":      testTargetID,
+			"retry_policy.#": "1",
+			"retry_policy.0.maximum_event_age_in_seconds": "60",
+			"retry_policy.0.maximum_retry_attempts":       "0",
+		},
+		Meta: map[string]interface{}{
+			"schema_version": "1",
+		},
+	}
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"arn":       arn,
+		"input":     `{"key":"value"}`,
+		"rule":      testTargetRuleName,
+		"target_id": testTargetID,
+		"retry_policy": []interface{}{map[string]interface{}{
+			"maximum_event_age_in_seconds": 60,
+		}},
+	}), meta)
+	if err != nil {
+		t.Fatalf("error diffing target: %s", err)
+	}
+
+	if diff == nil {
+		t.Fatal("expected input diff")
+	}
+
+	if v := diff.Attributes["retry_policy.0.maximum_retry_attempts"]; v != nil {
+		t.Fatalf("expected no maximum_retry_attempts diff, got %#v", v)
+	}
+
+	newState, diags := r.Apply(context.Background(), state, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("error updating target: %v", diags)
+	}
+
+	if putTargetsInput == nil {
+		t.Fatal("expected PutTargets to be called")
+	}
+
+	if got, expected := putTargetsInput.Targets[0].RetryPolicy.MaximumRetryAttempts, aws.Int64(0); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got MaximumRetryAttempts %v, expected %d", got, aws.Int64Value(expected))
+	}
+
+	if got, expected := newState.Attributes["retry_policy.0.maximum_retry_attempts"], "0"; got != expected {
+		t.Errorf("got maximum_retry_attempts %q in state, expected %q", got, expected)
+	}
+}
+
+func TestTargetImport_retryPolicyAndDeadLetterConfig(t *testing.T) {
+	const (
+		arn        = "arn:aws:sqs:us-west-2:123456789012:test"
+		deadLetter = "arn:aws:sqs:us-west-2:123456789012:dlq"
+	)
+
+	testCases := []struct {
+		Name     string
+		Target   *events.Target
+		Config   map[string]interface{}
+		Expected map[string]string
+	}{
+		{
+			Name: "explicit",
+			Target: &events.Target{
+				Arn:              aws.String(arn),
+				Id:               aws.String(testTargetID),
+				DeadLetterConfig: &events.DeadLetterConfig{Arn: aws.String(deadLetter)},
+				RetryPolicy: &events.RetryPolicy{
+					MaximumEventAgeInSeconds: aws.Int64(60),
+					MaximumRetryAttempts:     aws.Int64(5),
+				},
+			},
+			Config: map[string]interface{}{
+				"dead_letter_config": []interface{}{map[string]interface{}{
+					"arn": deadLetter,
+				}},
+				"retry_policy": []interface{}{map[string]interface{}{
+					"maximum_event_age_in_seconds": 60,
+					"maximum_retry_attempts":       5,
+				}},
+			},
+			Expected: map[string]string{
+				"dead_letter_config.#":                        "1",
+				"dead_letter_config.0.arn":                    deadLetter,
+				"retry_policy.#":                              "1",
+				"retry_policy.0.maximum_event_age_in_seconds": "60",
+				"retry_policy.0.maximum_retry_attempts":       "5",
+			},
+		},
+		{
+			Name: "zero retry attempts",
+			Target: &events.Target{
+				Arn: aws.String(arn),
+				Id:  aws.String(testTargetID),
+				RetryPolicy: &events.RetryPolicy{
+					MaximumEventAgeInSeconds: aws.Int64(3600),
+					MaximumRetryAttempts:     aws.Int64(0),
+				},
+			},
+			Config: map[string]interface{}{
+				"retry_policy": []interface{}{map[string]interface{}{
+					"maximum_event_age_in_seconds": 3600,
+					"maximum_retry_attempts":       0,
+				}},
+			},
+			Expected: map[string]string{
+				"retry_policy.#": "1",
+				"retry_policy.0.maximum_event_age_in_seconds": "3600",
+				"retry_policy.0.maximum_retry_attempts":       "0",
+			},
+		},
+		{
+			Name: "explicit defaults",
+			Target: &events.Target{
+				Arn: aws.String(arn),
+				Id:  aws.String(testTargetID),
+				RetryPolicy: &events.RetryPolicy{
+					MaximumEventAgeInSeconds: aws.Int64(86400),
+					MaximumRetryAttempts:     aws.Int64(185),
+				},
+			},
+			Config: map[string]interface{}{
+				"retry_policy": []interface{}{map[string]interface{}{
+					"maximum_event_age_in_seconds": 86400,
+					"maximum_retry_attempts":       185,
+				}},
+			},
+			Expected: map[string]string{
+				"retry_policy.#": "1",
+				"retry_policy.0.maximum_event_age_in_seconds": "86400",
+				"retry_policy.0.maximum_retry_attempts":       "185",
+			},
+		},
+		{
+			Name: "omitted arguments",
+			Target: &events.Target{
+				Arn: aws.String(arn),
+				Id:  aws.String(testTargetID),
+				RetryPolicy: &events.RetryPolicy{
+					MaximumEventAgeInSeconds: aws.Int64(3600),
+					MaximumRetryAttempts:     aws.Int64(185),
+				},
+			},
+			Config: map[string]interface{}{
+				"retry_policy": []interface{}{map[string]interface{}{
+					"maximum_event_age_in_seconds": 3600,
+				}},
+			},
+			Expected: map[string]string{
+				"retry_policy.#": "1",
+				"retry_policy.0.maximum_event_age_in_seconds": "3600",
+				"retry_policy.0.maximum_retry_attempts":       "185",
+			},
+		},
+		{
+			Name: "no retry policy",
+			Target: &events.Target{
+				Arn: aws.String(arn),
+				Id:  aws.String(testTargetID),
+			},
+			Config: map[string]interface{}{},
+			Expected: map[string]string{
+				"dead_letter_config.#": "0",
+				"retry_policy.#":       "0",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			meta := &conns.AWSClient{CloudWatchEventsConn: testTargetConn(t, [][]*events.Target{{testCase.Target}})}
+			r := ResourceTarget()
+
+			d := r.Data(nil)
+			d.SetId(testTargetRuleName + targetImportIDSeparator + testTargetID)
+
+			imported, err := r.Importer.State(d, meta)
+			if err != nil {
+				t.Fatalf("error importing target: %s", err)
+			}
+
+			d = imported[0]
+
+			if err := resourceTargetRead(d, meta); err != nil {
+				t.Fatalf("error reading target: %s", err)
+			}
+
+			state := d.State()
+
+			for k, v := range testCase.Expected {
+				if got := state.Attributes[k]; got != v && !(v == "0" && got == "") {
+					t.Errorf("attribute %s = %q, want %q", k, got, v)
+				}
+			}
+
+			raw := map[string]interface{}{
+				"arn":       arn,
+				"rule":      testTargetRuleName,
+				"target_id": testTargetID,
+			}
+			for k, v := range testCase.Config {
+				raw[k] = v
+			}
+
+			diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), meta)
+			if err != nil {
+				t.Fatalf("error diffing target: %s", err)
+			}
+
+			if diff != nil && !diff.Empty() {
+				t.Errorf("expected no diff after import, got: %v", diff.Attributes)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package cloudwatchevents_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
@@ -8,17 +9,126 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/stub"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tfcloudwatchevents "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatchevents"
 )
 
+func TestTargetCreate_httpPathParameters(t *testing.T) {
+	testCases := []struct {
+		Name                string
+		InvocationEndpoint  string
+		PathParameterValues []interface{}
+		ExpectedError       *regexp.Regexp
+	}{
+		{
+			Name:                "matching",
+			InvocationEndpoint:  "https://example.com/*/items/*",
+			PathParameterValues: []interface{}{"a", "b"},
+		},
+		{
+			Name:               "no placeholders",
+			InvocationEndpoint: "https://example.com/items",
+		},
+		{
+			Name:                "repeated values",
+			InvocationEndpoint:  "https://example.com/*/items/*",
+			PathParameterValues: []interface{}{"a", "a"},
+		},
+		{
+			Name:               "missing values",
+			InvocationEndpoint: "https://example.com/*",
+			ExpectedError:      regexp.MustCompile(`has 1 path parameter placeholders, got 0 values`),
+		},
+		{
+			Name:                "unexpected values",
+			InvocationEndpoint:  "https://example.com/items",
+			PathParameterValues: []interface{}{"a"},
+			ExpectedError:       regexp.MustCompile(`has 0 path parameter placeholders, got 1 values`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			const targetARN = "arn:aws:events:us-west-2:123456789012:api-destination/test/00000000-0000-0000-0000-000000000000" //lintignore:AWSAT003,AWSAT005
+
+			var putTargetsCalled bool
+
+			conn := events.New(stub.Session(t))
+			stub.Send(conn.Client, func(r *request.Request) {
+				switch output := r.Data.(type) {
+				case *events.DescribeApiDestinationOutput:
+					if got, expected := aws.StringValue(r.Params.(*events.DescribeApiDestinationInput).Name), "test"; got != expected {
+						t.Errorf("got DescribeApiDestination name %q, expected %q", got, expected)
+					}
+
+					output.InvocationEndpoint = aws.String(testCase.InvocationEndpoint)
+					output.Name = aws.String("test")
+				case *events.PutTargetsOutput:
+					putTargetsCalled = true
+				case *events.ListTargetsByRuleOutput:
+					output.Targets = []*events.Target{{
+						Arn: aws.String(targetARN),
+						Id:  aws.String("test"),
+					}}
+				}
+			})
+
+			raw := map[string]interface{}{
+				"arn":       targetARN,
+				"rule":      "test",
+				"target_id": "test",
+			}
+
+			if testCase.PathParameterValues != nil {
+				raw["http_target"] = []interface{}{map[string]interface{}{
+					"path_parameter_values": testCase.PathParameterValues,
+				}}
+			}
+
+			meta := &conns.AWSClient{CloudWatchEventsConn: conn}
+			r := tfcloudwatchevents.ResourceTarget()
+
+			diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), meta)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			_, diags := r.Apply(context.Background(), nil, diff, meta)
+
+			if testCase.ExpectedError != nil {
+				if !diags.HasError() {
+					t.Fatal("expected error, got none")
+				}
+
+				if got := diags[0].Summary; !testCase.ExpectedError.MatchString(got) {
+					t.Errorf("unexpected error: %s", got)
+				}
+
+				if putTargetsCalled {
+					t.Error("expected PutTargets not to be called")
+				}
+
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+		})
+	}
+}
+
 func TestAccCloudWatchEventsTarget_basic(t *testing.T) {
 	resourceName := "aws_cloudwatch_event_target.test"
 	snsTopicResourceName := "aws_sns_topic.test"
@@ -87,6 +197,46 @@ func TestAccCloudWatchEventsTarget_basic(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchEventsTarget_defaultEventBusName(t *testing.T) {
+	resourceName := "aws_cloudwatch_event_target.test"
+	ruleResourceName := "aws_cloudwatch_event_rule.test"
+
+	var v events.Target
+	ruleName := sdkacctest.RandomWithPrefix("tf-acc-test-rule")
+	snsTopicName := sdkacctest.RandomWithPrefix("tf-acc-test-sns")
+	targetID := sdkacctest.RandomWithPrefix("tf-acc-test-target")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, events.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetDefaultEventBusNameConfig(ruleName, snsTopicName, targetID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventTargetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(ruleResourceName, "event_bus_name", "default"),
+					resource.TestCheckResourceAttr(ruleResourceName, "id", ruleName),
+					resource.TestCheckResourceAttr(resourceName, "rule", ruleName),
+					resource.TestCheckResourceAttr(resourceName, "event_bus_name", "default"),
+					resource.TestCheckResourceAttr(resourceName, "target_id", targetID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTargetImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccTargetConfig(ruleName, snsTopicName, targetID),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccCloudWatchEventsTarget_eventBusName(t *testing.T) {
 	resourceName := "aws_cloudwatch_event_target.test"
 
@@ -235,6 +385,66 @@ func TestAccCloudWatchEventsTarget_RetryPolicy_deadLetter(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "dead_letter_config.0.arn", queueResourceName, "arn"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTargetImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccTargetConfig_retryPolicyDlc(ruleName, targetID, ssmDocumentName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchEventsTarget_RetryPolicy_defaults(t *testing.T) {
+	resourceName := "aws_cloudwatch_event_target.test"
+	var v events.Target
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, events.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetConfig_retryPolicyDefaults(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventTargetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "retry_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retry_policy.0.maximum_event_age_in_seconds", "86400"),
+					resource.TestCheckResourceAttr(resourceName, "retry_policy.0.maximum_retry_attempts", "185"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTargetImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccTargetConfig_retryPolicyDefaults(rName),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccTargetConfig_retryPolicyMaximumEventAgeInSeconds(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventTargetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "retry_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retry_policy.0.maximum_event_age_in_seconds", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "retry_policy.0.maximum_retry_attempts", "185"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTargetImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -559,6 +769,56 @@ func TestAccCloudWatchEventsTarget_ecsFull(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchEventsTarget_ecsPlacement(t *testing.T) {
+	resourceName := "aws_cloudwatch_event_target.test"
+	var v events.Target
+	rName := sdkacctest.RandomWithPrefix("tf_ecs_target")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, events.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetECSPlacementConfig(rName, "spread", "attribute:ecs.availability-zone", "binpack", "memory"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventTargetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.launch_type", "EC2"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.placement_constraint.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ecs_target.0.placement_constraint.*", map[string]string{
+						"expression": "attribute:ecs.instance-type =~ t3.*",
+						"type":       "memberOf",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.placement_strategy.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.placement_strategy.0.type", "spread"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.placement_strategy.0.field", "attribute:ecs.availability-zone"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.placement_strategy.1.type", "binpack"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.placement_strategy.1.field", "memory"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTargetImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTargetECSPlacementConfig(rName, "binpack", "cpu", "spread", "instanceId"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventTargetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.placement_strategy.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.placement_strategy.0.type", "binpack"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.placement_strategy.0.field", "cpu"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.placement_strategy.1.type", "spread"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.placement_strategy.1.field", "instanceId"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudWatchEventsTarget_batch(t *testing.T) {
 	resourceName := "aws_cloudwatch_event_target.test"
 	batchJobDefinitionResourceName := "aws_batch_job_definition.test"
@@ -578,6 +838,8 @@ func TestAccCloudWatchEventsTarget_batch(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "batch_target.#", "1"),
 					resource.TestCheckResourceAttrPair(resourceName, "batch_target.0.job_definition", batchJobDefinitionResourceName, "arn"),
 					resource.TestCheckResourceAttr(resourceName, "batch_target.0.job_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "batch_target.0.array_size", "2"),
+					resource.TestCheckResourceAttr(resourceName, "batch_target.0.job_attempts", "3"),
 				),
 			},
 			{
@@ -590,6 +852,23 @@ func TestAccCloudWatchEventsTarget_batch(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchEventsTarget_multipleTargetTypes(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, events.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTargetMultipleTargetTypesConfig(rName),
+				ExpectError: regexp.MustCompile(`"batch_target": conflicts with kinesis_target`),
+			},
+		},
+	})
+}
+
 func TestAccCloudWatchEventsTarget_kinesis(t *testing.T) {
 	resourceName := "aws_cloudwatch_event_target.test"
 	var v events.Target
@@ -602,7 +881,11 @@ func TestAccCloudWatchEventsTarget_kinesis(t *testing.T) {
 		CheckDestroy: testAccCheckTargetDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccTargetKinesisConfig(rName),
+				Config:      testAccTargetKinesisConfig(rName, "detail"),
+				ExpectError: regexp.MustCompile(`must be a JSONPath expression starting with`),
+			},
+			{
+				Config: testAccTargetKinesisConfig(rName, "$.detail"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCloudWatchEventTargetExists(resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "kinesis_target.#", "1"),
@@ -614,6 +897,14 @@ func TestAccCloudWatchEventsTarget_kinesis(t *testing.T) {
 				ImportState:       true,
 				ImportStateIdFunc: testAccTargetImportStateIdFunc(resourceName), ImportStateVerify: true,
 			},
+			{
+				Config: testAccTargetKinesisConfig(rName, "$.detail.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventTargetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "kinesis_target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "kinesis_target.0.partition_key_path", "$.detail.id"),
+				),
+			},
 		},
 	})
 }
@@ -1048,6 +1339,53 @@ data "aws_partition" "current" {}
 `, ruleName, rName, targetName)
 }
 
+func testAccTargetConfig_retryPolicyDefaults(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_rule" "test" {
+  name                = %[1]q
+  schedule_expression = "rate(1 hour)"
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_target" "test" {
+  rule      = aws_cloudwatch_event_rule.test.name
+  target_id = %[1]q
+  arn       = aws_sqs_queue.test.arn
+
+  retry_policy {
+    maximum_event_age_in_seconds = 86400
+    maximum_retry_attempts       = 185
+  }
+}
+`, rName)
+}
+
+func testAccTargetConfig_retryPolicyMaximumEventAgeInSeconds(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_rule" "test" {
+  name                = %[1]q
+  schedule_expression = "rate(1 hour)"
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_target" "test" {
+  rule      = aws_cloudwatch_event_rule.test.name
+  target_id = %[1]q
+  arn       = aws_sqs_queue.test.arn
+
+  retry_policy {
+    maximum_event_age_in_seconds = 3600
+  }
+}
+`, rName)
+}
+
 func testAccTargetConfig_full(ruleName, targetName, rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_rule" "test" {
@@ -1490,6 +1828,37 @@ resource "aws_cloudwatch_event_target" "test" {
 `
 }
 
+func testAccTargetECSPlacementConfig(rName, type1, field1, type2, field2 string) string {
+	return testAccTargetECSBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_cloudwatch_event_target" "test" {
+  arn      = aws_ecs_cluster.test.id
+  rule     = aws_cloudwatch_event_rule.test.id
+  role_arn = aws_iam_role.test.arn
+
+  ecs_target {
+    task_count          = 1
+    task_definition_arn = aws_ecs_task_definition.task.arn
+    launch_type         = "EC2"
+
+    placement_constraint {
+      type       = "memberOf"
+      expression = "attribute:ecs.instance-type =~ t3.*"
+    }
+
+    placement_strategy {
+      type  = %[1]q
+      field = %[2]q
+    }
+
+    placement_strategy {
+      type  = %[3]q
+      field = %[4]q
+    }
+  }
+}
+`, type1, field1, type2, field2)
+}
+
 func testAccTargetBatchConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_rule" "test" {
@@ -1506,6 +1875,8 @@ resource "aws_cloudwatch_event_target" "test" {
   batch_target {
     job_definition = aws_batch_job_definition.test.arn
     job_name       = "%[1]s"
+    array_size     = 2
+    job_attempts   = 3
   }
 
   depends_on = [
@@ -1658,7 +2029,36 @@ CONTAINER_PROPERTIES
 `, rName)
 }
 
-func testAccTargetKinesisConfig(rName string) string {
+func testAccTargetMultipleTargetTypesConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_rule" "test" {
+  name                = %[1]q
+  schedule_expression = "rate(5 minutes)"
+}
+
+resource "aws_cloudwatch_event_target" "test" {
+  arn  = "arn:${data.aws_partition.current.partition}:batch:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:job-queue/%[1]s"
+  rule = aws_cloudwatch_event_rule.test.id
+
+  batch_target {
+    job_definition = %[1]q
+    job_name       = %[1]q
+  }
+
+  kinesis_target {
+    partition_key_path = "$.detail"
+  }
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, rName)
+}
+
+func testAccTargetKinesisConfig(rName, partitionKeyPath string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_rule" "test" {
   name                = "%[1]s"
@@ -1672,7 +2072,7 @@ resource "aws_cloudwatch_event_target" "test" {
   role_arn = aws_iam_role.test.arn
 
   kinesis_target {
-    partition_key_path = "$.detail"
+    partition_key_path = %[2]q
   }
 }
 
@@ -1701,7 +2101,7 @@ resource "aws_kinesis_stream" "test" {
 }
 
 data "aws_partition" "current" {}
-`, rName)
+`, rName, partitionKeyPath)
 }
 
 func testAccTargetSQSConfig(rName string) string {
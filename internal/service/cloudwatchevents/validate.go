@@ -1,9 +1,13 @@
 package cloudwatchevents
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"sort"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -45,6 +49,26 @@ func validateCloudWatchEventTargetId(v interface{}, k string) (ws []string, erro
 	return
 }
 
+// targetInputMaxLength is the maximum size in bytes of a target's static input.
+const targetInputMaxLength = 8192
+
+// validateTargetInput validates that a target's static input is JSON text of at most 8192 bytes.
+func validateTargetInput(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) > targetInputMaxLength {
+		errors = append(errors, fmt.Errorf("%q must be at most %d bytes, got %d", k, targetInputMaxLength, len(value)))
+		return
+	}
+
+	var input interface{}
+	if err := json.Unmarshal([]byte(value), &input); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be valid JSON: %w", k, err))
+	}
+
+	return
+}
+
 func mapKeysDoNotMatch(r *regexp.Regexp, message string) schema.SchemaValidateFunc {
 	return func(i interface{}, k string) (warnings []string, errors []error) {
 		m, ok := i.(map[string]interface{})
@@ -102,3 +126,169 @@ var validCustomEventBusName = validation.All(
 	validation.StringMatch(regexp.MustCompile(`^[/\.\-_A-Za-z0-9]+$`), ""),
 	validation.StringDoesNotMatch(regexp.MustCompile(`^default$`), "cannot be 'default'"),
 )
+
+var validTargetKinesisPartitionKeyPath = validation.All(
+	validation.StringLenBetween(1, 256),
+	validation.StringMatch(regexp.MustCompile(`^\$`), "must be a JSONPath expression starting with `$`"),
+)
+
+// validateRuleEventPatternOrScheduleExpression checks that exactly one of event pattern or schedule expression is configured
+// and that scheduled rules are only created on the default event bus.
+func validateRuleEventPatternOrScheduleExpression(eventBusName, eventPattern, scheduleExpression string) error {
+	if eventPattern == "" && scheduleExpression == "" {
+		return fmt.Errorf("one of `event_pattern` or `schedule_expression` must be specified")
+	}
+
+	if eventPattern != "" && scheduleExpression != "" {
+		return fmt.Errorf("only one of `event_pattern` or `schedule_expression` can be specified")
+	}
+
+	if scheduleExpression != "" && !isDefaultEventBus(eventBusName) {
+		return fmt.Errorf("`schedule_expression` can only be used with the default event bus, got %q", eventBusName)
+	}
+
+	return nil
+}
+
+// isDefaultEventBus returns whether the specified event bus name or ARN refers to the default event bus.
+func isDefaultEventBus(eventBusName string) bool {
+	if eventBusName == "" || eventBusName == DefaultEventBusName {
+		return true
+	}
+
+	if v, err := arn.Parse(eventBusName); err == nil {
+		return v.Resource == "event-bus/"+DefaultEventBusName
+	}
+
+	return false
+}
+
+// eventPatternContentFilterOperators lists the operators supported in event pattern content filters.
+// See https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-event-patterns-content-based-filtering.html.
+var eventPatternContentFilterOperators = []string{
+	"anything-but",
+	"cidr",
+	"equals-ignore-case",
+	"exists",
+	"numeric",
+	"prefix",
+	"suffix",
+	"wildcard",
+}
+
+// validateEventPatternContentFilters validates the content filters, e.g. {"prefix": "..."}, in an event pattern.
+func validateEventPatternContentFilters(pattern string) error {
+	var v interface{}
+
+	if err := json.Unmarshal([]byte(pattern), &v); err != nil {
+		return err
+	}
+
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return fmt.Errorf("event pattern must be a JSON object")
+	}
+
+	return validateEventPatternObject(m, "")
+}
+
+func validateEventPatternObject(m map[string]interface{}, path string) error {
+	// Sort keys so that the first error is reported deterministically.
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		fieldPath := k
+		if path != "" {
+			fieldPath = path + "." + k
+		}
+
+		switch v := m[k].(type) {
+		case map[string]interface{}:
+			if err := validateEventPatternObject(v, fieldPath); err != nil {
+				return err
+			}
+		case []interface{}:
+			for _, v := range v {
+				filter, ok := v.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				// "$or" matches any of a list of event patterns.
+				if k == "$or" {
+					if err := validateEventPatternObject(filter, path); err != nil {
+						return err
+					}
+
+					continue
+				}
+
+				if err := validateEventPatternContentFilter(filter, fieldPath); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateEventPatternContentFilter(filter map[string]interface{}, path string) error {
+	for operator, v := range filter {
+		switch operator {
+		case "equals-ignore-case":
+			if _, ok := v.(string); !ok {
+				return fmt.Errorf("content filter %q for %q must be a string, got %s", operator, path, eventPatternValueType(v))
+			}
+		case "wildcard":
+			s, ok := v.(string)
+
+			if !ok {
+				return fmt.Errorf("content filter %q for %q must be a string, got %s", operator, path, eventPatternValueType(v))
+			}
+
+			if strings.Contains(s, "**") {
+				return fmt.Errorf("content filter %q for %q cannot contain consecutive wildcard characters: %q", operator, path, s)
+			}
+		default:
+			if !eventPatternContentFilterOperatorSupported(operator) {
+				return fmt.Errorf("unknown content filter operator %q for %q, expected one of: %s", operator, path, strings.Join(eventPatternContentFilterOperators, ", "))
+			}
+		}
+	}
+
+	return nil
+}
+
+func eventPatternContentFilterOperatorSupported(operator string) bool {
+	for _, v := range eventPatternContentFilterOperators {
+		if v == operator {
+			return true
+		}
+	}
+
+	return false
+}
+
+func eventPatternValueType(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
@@ -1,6 +1,8 @@
 package cloudwatchevents
 
 import (
+	"regexp"
+	"strings"
 	"testing"
 
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -149,3 +151,274 @@ func TestValidRuleName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidTargetKinesisPartitionKeyPath(t *testing.T) {
+	cases := []struct {
+		Value   string
+		IsValid bool
+	}{
+		{
+			Value:   "",
+			IsValid: false,
+		},
+		{
+			Value:   "$",
+			IsValid: true,
+		},
+		{
+			Value:   "$.detail",
+			IsValid: true,
+		},
+		{
+			Value:   "$.detail.items[0].id",
+			IsValid: true,
+		},
+		{
+			Value:   "detail",
+			IsValid: false,
+		},
+		{
+			Value:   ".detail",
+			IsValid: false,
+		},
+		{
+			Value:   "$." + sdkacctest.RandStringFromCharSet(254, sdkacctest.CharSetAlpha),
+			IsValid: true,
+		},
+		{
+			Value:   "$." + sdkacctest.RandStringFromCharSet(255, sdkacctest.CharSetAlpha),
+			IsValid: false,
+		},
+	}
+	for _, tc := range cases {
+		_, errors := validTargetKinesisPartitionKeyPath(tc.Value, "partition_key_path")
+		isValid := len(errors) == 0
+		if tc.IsValid && !isValid {
+			t.Errorf("expected %q to return valid, but did not", tc.Value)
+		} else if !tc.IsValid && isValid {
+			t.Errorf("expected %q to not return valid, but did", tc.Value)
+		}
+	}
+}
+
+func TestValidateRuleEventPatternOrScheduleExpression(t *testing.T) {
+	cases := []struct {
+		Name               string
+		EventBusName       string
+		EventPattern       string
+		ScheduleExpression string
+		ExpectError        bool
+	}{
+		{
+			Name:         "event pattern",
+			EventBusName: DefaultEventBusName,
+			EventPattern: `{"source":["aws.ec2"]}`,
+		},
+		{
+			Name:               "schedule expression",
+			EventBusName:       DefaultEventBusName,
+			ScheduleExpression: "rate(1 hour)",
+		},
+		{
+			Name:               "schedule expression default event bus ARN",
+			EventBusName:       "arn:aws:events:us-east-1:123456789012:event-bus/default",
+			ScheduleExpression: "rate(1 hour)",
+		},
+		{
+			Name:         "event pattern custom event bus",
+			EventBusName: "custom",
+			EventPattern: `{"source":["aws.ec2"]}`,
+		},
+		{
+			Name:               "both set",
+			EventBusName:       DefaultEventBusName,
+			EventPattern:       `{"source":["aws.ec2"]}`,
+			ScheduleExpression: "rate(1 hour)",
+			ExpectError:        true,
+		},
+		{
+			Name:         "neither set",
+			EventBusName: DefaultEventBusName,
+			ExpectError:  true,
+		},
+		{
+			Name:               "schedule expression custom event bus",
+			EventBusName:       "custom",
+			ScheduleExpression: "rate(1 hour)",
+			ExpectError:        true,
+		},
+		{
+			Name:               "schedule expression custom event bus ARN",
+			EventBusName:       "arn:aws:events:us-east-1:123456789012:event-bus/custom",
+			ScheduleExpression: "rate(1 hour)",
+			ExpectError:        true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := validateRuleEventPatternOrScheduleExpression(tc.EventBusName, tc.EventPattern, tc.ScheduleExpression)
+
+			if tc.ExpectError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !tc.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidateEventPatternValue(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Value         string
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			Name:  "exact match",
+			Value: `{"source":["aws.ec2"],"detail":{"state":["running"]}}`,
+		},
+		{
+			Name:  "existing operators",
+			Value: `{"source":[{"prefix":"aws."}],"detail":{"count":[{"numeric":[">",0]}],"state":[{"anything-but":{"prefix":"stop"}}],"ip":[{"cidr":"10.0.0.0/24"}],"tag":[{"exists":true}],"key":[{"suffix":".png"}]}}`,
+		},
+		{
+			Name:  "equals-ignore-case",
+			Value: `{"detail":{"state":[{"equals-ignore-case":"Running"}]}}`,
+		},
+		{
+			Name:          "equals-ignore-case array",
+			Value:         `{"detail":{"state":[{"equals-ignore-case":["running"]}]}}`,
+			ExpectedError: regexp.MustCompile(`content filter "equals-ignore-case" for "detail.state" must be a string, got array`),
+		},
+		{
+			Name:          "equals-ignore-case number",
+			Value:         `{"detail":{"state":[{"equals-ignore-case":5}]}}`,
+			ExpectedError: regexp.MustCompile(`content filter "equals-ignore-case" for "detail.state" must be a string, got number`),
+		},
+		{
+			Name:  "wildcard",
+			Value: `{"detail":{"bucket":{"key":[{"wildcard":"dir/*.png"}]}}}`,
+		},
+		{
+			Name:          "wildcard object",
+			Value:         `{"detail":{"key":[{"wildcard":{"prefix":"dir/"}}]}}`,
+			ExpectedError: regexp.MustCompile(`content filter "wildcard" for "detail.key" must be a string, got object`),
+		},
+		{
+			Name:          "wildcard consecutive wildcard characters",
+			Value:         `{"detail":{"key":[{"wildcard":"dir/**.png"}]}}`,
+			ExpectedError: regexp.MustCompile(`content filter "wildcard" for "detail.key" cannot contain consecutive wildcard characters`),
+		},
+		{
+			Name:          "unknown operator",
+			Value:         `{"detail":{"state":[{"contains":"run"}]}}`,
+			ExpectedError: regexp.MustCompile(`unknown content filter operator "contains" for "detail.state"`),
+		},
+		{
+			Name:  "$or",
+			Value: `{"detail":{"$or":[{"state":[{"equals-ignore-case":"running"}]},{"count":[{"numeric":[">",5]}]}]}}`,
+		},
+		{
+			Name:          "$or unknown operator",
+			Value:         `{"detail":{"$or":[{"state":[{"matches":"running"}]}]}}`,
+			ExpectedError: regexp.MustCompile(`unknown content filter operator "matches" for "detail.state"`),
+		},
+		{
+			Name:          "invalid JSON",
+			Value:         `{"detail":`,
+			ExpectedError: regexp.MustCompile(`contains an invalid JSON`),
+		},
+		{
+			Name:  "within size limit after normalization",
+			Value: `{"detail": {"key": ["` + strings.Repeat("a", 4000) + `"]}}` + strings.Repeat(" ", 200),
+		},
+		{
+			Name:          "exceeds size limit",
+			Value:         `{"detail":{"key":["` + strings.Repeat("a", 4100) + `"]}}`,
+			ExpectedError: regexp.MustCompile(`cannot be longer than 4096 bytes after normalization, got 4123 bytes`),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, errors := validateEventPatternValue()(tc.Value, "event_pattern")
+
+			if tc.ExpectedError == nil {
+				if len(errors) > 0 {
+					t.Fatalf("unexpected errors: %v", errors)
+				}
+
+				return
+			}
+
+			if len(errors) != 1 {
+				t.Fatalf("expected 1 error, got %d: %v", len(errors), errors)
+			}
+
+			if !tc.ExpectedError.MatchString(errors[0].Error()) {
+				t.Errorf("expected error matching %q, got %q", tc.ExpectedError, errors[0])
+			}
+		})
+	}
+}
+
+func TestValidateTargetInput(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         string
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			Name:  "object",
+			Value: `{"instance_id":"i-123456"}`,
+		},
+		{
+			Name:  "string",
+			Value: `"text"`,
+		},
+		{
+			Name:  "maximum size",
+			Value: `"` + strings.Repeat("a", 8190) + `"`,
+		},
+		{
+			Name:          "invalid JSON",
+			Value:         `{"instance_id":`,
+			ExpectedError: regexp.MustCompile(`must be valid JSON`),
+		},
+		{
+			Name:          "unquoted text",
+			Value:         `text`,
+			ExpectedError: regexp.MustCompile(`must be valid JSON`),
+		},
+		{
+			Name:          "oversized",
+			Value:         `"` + strings.Repeat("a", 8191) + `"`,
+			ExpectedError: regexp.MustCompile(`must be at most 8192 bytes, got 8193`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, errs := validateTargetInput(testCase.Value, "input")
+
+			if testCase.ExpectedError == nil {
+				if len(errs) > 0 {
+					t.Fatalf("unexpected error: %s", errs[0])
+				}
+
+				return
+			}
+
+			if len(errs) == 0 {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError.MatchString(errs[0].Error()) {
+				t.Errorf("unexpected error: %s", errs[0])
+			}
+		})
+	}
+}
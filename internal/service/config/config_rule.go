@@ -2,6 +2,7 @@ package config
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -9,6 +10,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -41,6 +44,10 @@ func ResourceConfigRule() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"rule_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -51,9 +58,10 @@ func ResourceConfigRule() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(0, 256),
 			},
 			"input_parameters": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringIsJSON,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				ValidateFunc:     validation.StringIsJSON,
 			},
 			"maximum_execution_frequency": {
 				Type:         schema.TypeString,
@@ -144,10 +152,37 @@ func ResourceConfigRule() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceRuleCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourceRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("scope") {
+		return nil
+	}
+
+	v, ok := diff.Get("scope").([]interface{})
+
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	tfMap := v[0].(map[string]interface{})
+
+	var resourceTypes []string
+
+	if v, ok := tfMap["compliance_resource_types"].(*schema.Set); ok {
+		for _, v := range v.List() {
+			resourceTypes = append(resourceTypes, v.(string))
+		}
+	}
+
+	return validRuleScope(tfMap["compliance_resource_id"].(string), resourceTypes, tfMap["tag_key"].(string), tfMap["tag_value"].(string))
+}
+
 func resourceRulePutConfig(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ConfigConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -194,6 +229,9 @@ func resourceRulePutConfig(d *schema.ResourceData, meta interface{}) error {
 		_, err = conn.PutConfigRule(&input)
 	}
 	if err != nil {
+		if tfawserr.ErrCodeEquals(err, configservice.ErrCodeInsufficientPermissionsException) && aws.StringValue(ruleInput.Source.Owner) == configservice.OwnerCustomLambda {
+			return fmt.Errorf("Error creating AWSConfig rule: %s (ensure that the AWS Lambda function's resource-based policy allows config.amazonaws.com to invoke it and that its execution role allows config:PutEvaluations)", err)
+		}
 		return fmt.Errorf("Error creating AWSConfig rule: %s", err)
 	}
 
@@ -201,6 +239,13 @@ func resourceRulePutConfig(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] AWSConfig config rule %q created", name)
 
+	// Custom rules are evaluated asynchronously by the AWS Lambda function.
+	if aws.StringValue(ruleInput.Source.Owner) == configservice.OwnerCustomLambda {
+		if err := configWaitForConfigRuleStateActive(conn, name, ConfigRuleActiveTimeout); err != nil {
+			return fmt.Errorf("error waiting for AWSConfig config rule (%s) to become active: %w", name, err)
+		}
+	}
+
 	if !d.IsNewResource() && d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -246,6 +291,7 @@ func resourceConfigRuleRead(d *schema.ResourceData, meta interface{}) error {
 	rule := out.ConfigRules[0]
 	d.Set("arn", rule.ConfigRuleArn)
 	d.Set("rule_id", rule.ConfigRuleId)
+	d.Set("rule_state", rule.ConfigRuleState)
 	d.Set("name", rule.ConfigRuleName)
 	d.Set("description", rule.Description)
 	d.Set("input_parameters", rule.InputParameters)
@@ -1,6 +1,7 @@
 package config_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -12,8 +13,78 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconfig "github.com/hashicorp/terraform-provider-aws/internal/service/config"
 )
 
+func TestConfigRuleDiff_inputParameters(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		StateParameters  string
+		ConfigParameters string
+		ExpectDiff       bool
+	}{
+		{
+			Name:             "reordered by AWS",
+			StateParameters:  `{"maxAccessKeyAge":"90","ignoreUsers":"a,b"}`,
+			ConfigParameters: "{\n  \"ignoreUsers\": \"a,b\",\n  \"maxAccessKeyAge\": \"90\"\n}",
+		},
+		{
+			Name:             "nested reordered by AWS",
+			StateParameters:  `{"b":{"y":"2","x":"1"},"a":["1","2"]}`,
+			ConfigParameters: `{"a":["1","2"],"b":{"x":"1","y":"2"}}`,
+		},
+		{
+			Name:             "value changed",
+			StateParameters:  `{"maxAccessKeyAge":"90","ignoreUsers":"a,b"}`,
+			ConfigParameters: `{"ignoreUsers":"a,b","maxAccessKeyAge":"30"}`,
+			ExpectDiff:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "test",
+				Attributes: map[string]string{
+					"id":                         "test",
+					"arn":                        "arn:aws:config:us-west-2:123456789012:config-rule/config-rule-abcdef", //lintignore:AWSAT003,AWSAT005
+					"input_parameters":           testCase.StateParameters,
+					"name":                       "test",
+					"source.#":                   "1",
+					"source.0.owner":             configservice.OwnerAws,
+					"source.0.source_detail.#":   "0",
+					"source.0.source_identifier": "ACCESS_KEYS_ROTATED",
+					"tags.%":                     "0",
+					"tags_all.%":                 "0",
+				},
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"input_parameters": testCase.ConfigParameters,
+				"name":             "test",
+				"source": []interface{}{map[string]interface{}{
+					"owner":             configservice.OwnerAws,
+					"source_identifier": "ACCESS_KEYS_ROTATED",
+				}},
+			})
+
+			diff, err := tfconfig.ResourceConfigRule().Diff(context.Background(), state, config, &conns.AWSClient{})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var hasDiff bool
+			if diff != nil {
+				_, hasDiff = diff.Attributes["input_parameters"]
+			}
+
+			if hasDiff != testCase.ExpectDiff {
+				t.Errorf("got input_parameters diff %t, expected %t: %#v", hasDiff, testCase.ExpectDiff, diff)
+			}
+		})
+	}
+}
+
 func testAccConfigConfigRule_basic(t *testing.T) {
 	var cr configservice.ConfigRule
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -96,6 +167,7 @@ func testAccConfigConfigRule_customlambda(t *testing.T) {
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "config", regexp.MustCompile("config-rule/config-rule-[a-z0-9]+$")),
 					resource.TestCheckResourceAttr(resourceName, "name", expectedName),
 					resource.TestMatchResourceAttr(resourceName, "rule_id", regexp.MustCompile("config-rule-[a-z0-9]+$")),
+					resource.TestCheckResourceAttr(resourceName, "rule_state", configservice.ConfigRuleStateActive),
 					resource.TestCheckResourceAttr(resourceName, "description", "Terraform Acceptance tests"),
 					resource.TestCheckResourceAttr(resourceName, "maximum_execution_frequency", "Six_Hours"),
 					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
@@ -247,6 +319,23 @@ func testAccConfigConfigRule_Scope_TagValue(t *testing.T) {
 	})
 }
 
+func testAccConfigConfigRule_Scope_invalid(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, configservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigConfigRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfigConfigRuleConfig_Scope_ResourceIDAndResourceTypes(rName),
+				ExpectError: regexp.MustCompile(`compliance_resource_id requires exactly one compliance_resource_types entry, got 2`),
+			},
+		},
+	})
+}
+
 func testAccConfigConfigRule_tags(t *testing.T) {
 	var cr configservice.ConfigRule
 	resourceName := "aws_config_config_rule.test"
@@ -621,6 +710,26 @@ resource "aws_config_config_rule" "test" {
 `, rName, tagValue)
 }
 
+func testAccConfigConfigRuleConfig_Scope_ResourceIDAndResourceTypes(rName string) string {
+	return testAccConfigConfigRuleConfig_base(rName) + fmt.Sprintf(`
+resource "aws_config_config_rule" "test" {
+  name = %q
+
+  scope {
+    compliance_resource_id    = "example"
+    compliance_resource_types = ["AWS::S3::Bucket", "AWS::EC2::Instance"]
+  }
+
+  source {
+    owner             = "AWS"
+    source_identifier = "S3_BUCKET_VERSIONING_ENABLED"
+  }
+
+  depends_on = [aws_config_configuration_recorder.test]
+}
+`, rName)
+}
+
 func testAccConfigConfigRuleConfig_Tags(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
 	return testAccConfigConfigRuleConfig_base(rName) + fmt.Sprintf(`
 resource "aws_config_config_rule" "test" {
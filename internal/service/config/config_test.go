@@ -15,6 +15,7 @@ func TestAccConfig_serial(t *testing.T) {
 			"scopeTagKey":      testAccConfigConfigRule_Scope_TagKey,
 			"scopeTagKeyEmpty": testAccConfigConfigRule_Scope_TagKey_Empty,
 			"scopeTagValue":    testAccConfigConfigRule_Scope_TagValue,
+			"scopeInvalid":     testAccConfigConfigRule_Scope_invalid,
 			"tags":             testAccConfigConfigRule_tags,
 		},
 		"ConfigurationRecorderStatus": {
@@ -23,15 +24,22 @@ func TestAccConfig_serial(t *testing.T) {
 			"importBasic":  testAccConfigConfigurationRecorderStatus_importBasic,
 		},
 		"ConfigurationRecorder": {
-			"basic":       testAccConfigConfigurationRecorder_basic,
-			"allParams":   testAccConfigConfigurationRecorder_allParams,
-			"importBasic": testAccConfigConfigurationRecorder_importBasic,
+			"basic":               testAccConfigConfigurationRecorder_basic,
+			"allParams":           testAccConfigConfigurationRecorder_allParams,
+			"importBasic":         testAccConfigConfigurationRecorder_importBasic,
+			"noRecordingGroup":    testAccConfigConfigurationRecorder_noRecordingGroup,
+			"globalResourcesOnly": testAccConfigConfigurationRecorder_globalResourcesOnly,
+			"status":              testAccConfigConfigurationRecorder_status,
+		},
+		"ConfigurationRecordersDataSource": {
+			"basic": testAccConfigConfigurationRecordersDataSource_basic,
 		},
 		"ConformancePack": {
 			"basic":                     testAccConfigConformancePack_basic,
 			"disappears":                testAccConfigConformancePack_disappears,
 			"forceNew":                  testAccConfigConformancePack_forceNew,
 			"inputParameters":           testAccConfigConformancePack_inputParameters,
+			"inputParametersOrder":      testAccConfigConformancePack_inputParametersOrder,
 			"S3Delivery":                testAccConfigConformancePack_S3Delivery,
 			"S3Template":                testAccConfigConformancePack_S3Template,
 			"S3TemplateAndTemplateBody": testAccConfigConformancePack_S3TemplateAndTemplateBody,
@@ -41,9 +49,10 @@ func TestAccConfig_serial(t *testing.T) {
 			"updateTemplateBody":        testAccConfigConformancePack_updateTemplateBody,
 		},
 		"DeliveryChannel": {
-			"basic":       testAccConfigDeliveryChannel_basic,
-			"allParams":   testAccConfigDeliveryChannel_allParams,
-			"importBasic": testAccConfigDeliveryChannel_importBasic,
+			"basic":             testAccConfigDeliveryChannel_basic,
+			"allParams":         testAccConfigDeliveryChannel_allParams,
+			"deliveryFrequency": testAccConfigDeliveryChannel_deliveryFrequency,
+			"importBasic":       testAccConfigDeliveryChannel_importBasic,
 		},
 		"OrganizationConformancePack": {
 			"basic":                 testAccConfigOrganizationConformancePack_basic,
@@ -58,6 +67,10 @@ func TestAccConfig_serial(t *testing.T) {
 			"updateS3Template":      testAccConfigOrganizationConformancePack_updateS3Template,
 			"updateTemplateBody":    testAccConfigOrganizationConformancePack_updateTemplateBody,
 		},
+		"OrganizationConformancePackStatusDataSource": {
+			"basic":  testAccConfigOrganizationConformancePackStatusDataSource_basic,
+			"status": testAccConfigOrganizationConformancePackStatusDataSource_status,
+		},
 		"OrganizationCustomRule": {
 			"basic":                     testAccConfigOrganizationCustomRule_basic,
 			"disappears":                testAccConfigOrganizationCustomRule_disappears,
@@ -87,6 +100,9 @@ func TestAccConfig_serial(t *testing.T) {
 			"TagKeyScope":               testAccConfigOrganizationManagedRule_TagKeyScope,
 			"TagValueScope":             testAccConfigOrganizationManagedRule_TagValueScope,
 		},
+		"RuleEvaluation": {
+			"basic": testAccConfigRuleEvaluation_basic,
+		},
 		"RemediationConfiguration": {
 			"basic":      testAccConfigRemediationConfiguration_basic,
 			"disappears": testAccConfigRemediationConfiguration_disappears,
@@ -1,24 +1,89 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
+	ConfigRuleActiveTimeout = 5 * time.Minute
+
+	ConfigRuleStateNotFound = "NotFound"
+	ConfigRuleStateUnknown  = "Unknown"
+
 	ConfigConformancePackCreateTimeout = 5 * time.Minute
 	ConfigConformancePackDeleteTimeout = 5 * time.Minute
 
 	ConfigConformancePackStatusNotFound = "NotFound"
 	ConfigConformancePackStatusUnknown  = "Unknown"
+
+	configurationRecorderPutTimeout = 2 * time.Minute
+
+	deliveryChannelPutTimeout = 2 * time.Minute
+
+	// Error code missing from the AWS SDK, returned by PutConfigurationRecorder
+	// when it races with other operations on the recorder or delivery channel.
+	errCodeConcurrentModificationException = "ConcurrentModificationException"
 )
 
+// putConfigurationRecorder creates or updates a configuration recorder, retrying
+// while other operations on the recorder are in progress.
+func putConfigurationRecorder(conn *configservice.ConfigService, input *configservice.PutConfigurationRecorderInput) error {
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(configurationRecorderPutTimeout, func() (interface{}, error) {
+		return conn.PutConfigurationRecorder(input)
+	}, errCodeConcurrentModificationException)
+
+	return err
+}
+
+// putDeliveryChannel creates or updates a delivery channel, retrying while the IAM permissions
+// for the delivery destination propagate and while the configuration recorder is being created.
+func putDeliveryChannel(conn *configservice.ConfigService, input *configservice.PutDeliveryChannelInput) error {
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(deliveryChannelPutTimeout, func() (interface{}, error) {
+		return conn.PutDeliveryChannel(input)
+	}, configservice.ErrCodeInsufficientDeliveryPolicyException, configservice.ErrCodeNoAvailableConfigurationRecorderException)
+
+	return err
+}
+
+func configDescribeConfigRule(conn *configservice.ConfigService, name string) (*configservice.ConfigRule, error) {
+	input := &configservice.DescribeConfigRulesInput{
+		ConfigRuleNames: []*string{aws.String(name)},
+	}
+
+	output, err := conn.DescribeConfigRules(input)
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConfigRuleException) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range output.ConfigRules {
+		if rule == nil {
+			continue
+		}
+
+		if aws.StringValue(rule.ConfigRuleName) == name {
+			return rule, nil
+		}
+	}
+
+	return nil, nil
+}
+
 func DescribeConformancePack(conn *configservice.ConfigService, name string) (*configservice.ConformancePackDetail, error) {
 	input := &configservice.DescribeConformancePacksInput{
 		ConformancePackNames: []*string{aws.String(name)},
@@ -79,6 +144,30 @@ func configDescribeConformancePackStatus(conn *configservice.ConfigService, name
 	return nil, nil
 }
 
+func describeAggregateComplianceByConfigRules(conn *configservice.ConfigService, input *configservice.DescribeAggregateComplianceByConfigRulesInput) ([]*configservice.AggregateComplianceByConfigRule, error) {
+	var output []*configservice.AggregateComplianceByConfigRule
+
+	err := conn.DescribeAggregateComplianceByConfigRulesPages(input, func(page *configservice.DescribeAggregateComplianceByConfigRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AggregateComplianceByConfigRules {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func DescribeOrganizationConfigRule(conn *configservice.ConfigService, name string) (*configservice.OrganizationConfigRule, error) {
 	input := &configservice.DescribeOrganizationConfigRulesInput{
 		OrganizationConfigRuleNames: []*string{aws.String(name)},
@@ -191,6 +280,94 @@ func configDescribeOrganizationConformancePackStatus(conn *configservice.ConfigS
 	return nil, nil
 }
 
+// configurationRecorderWithStatus pairs a configuration recorder with its current recording status.
+type configurationRecorderWithStatus struct {
+	Recorder *configservice.ConfigurationRecorder
+	Status   *configservice.ConfigurationRecorderStatus
+}
+
+// describeConfigurationRecordersWithStatus returns the configuration recorders with the specified names,
+// or all configuration recorders if no names are specified, together with their recording status.
+// The recorder and status lookups are each a single batched API call and are issued concurrently;
+// throttling errors are handled by the client's retryer.
+func describeConfigurationRecordersWithStatus(conn *configservice.ConfigService, names []*string) ([]*configurationRecorderWithStatus, error) {
+	var (
+		recorders   []*configservice.ConfigurationRecorder
+		statuses    []*configservice.ConfigurationRecorderStatus
+		recorderErr error
+		statusErr   error
+		wg          sync.WaitGroup
+	)
+
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+
+		input := &configservice.DescribeConfigurationRecordersInput{}
+		if len(names) > 0 {
+			input.ConfigurationRecorderNames = names
+		}
+
+		output, err := conn.DescribeConfigurationRecorders(input)
+
+		if err != nil {
+			recorderErr = fmt.Errorf("error describing Config Configuration Recorders: %w", err)
+			return
+		}
+
+		recorders = output.ConfigurationRecorders
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		input := &configservice.DescribeConfigurationRecorderStatusInput{}
+		if len(names) > 0 {
+			input.ConfigurationRecorderNames = names
+		}
+
+		output, err := conn.DescribeConfigurationRecorderStatus(input)
+
+		if err != nil {
+			statusErr = fmt.Errorf("error describing Config Configuration Recorder statuses: %w", err)
+			return
+		}
+
+		statuses = output.ConfigurationRecordersStatus
+	}()
+
+	wg.Wait()
+
+	if err := multierror.Append(recorderErr, statusErr).ErrorOrNil(); err != nil {
+		return nil, err
+	}
+
+	statusByName := make(map[string]*configservice.ConfigurationRecorderStatus, len(statuses))
+	for _, status := range statuses {
+		if status == nil {
+			continue
+		}
+
+		statusByName[aws.StringValue(status.Name)] = status
+	}
+
+	var results []*configurationRecorderWithStatus
+
+	for _, recorder := range recorders {
+		if recorder == nil {
+			continue
+		}
+
+		results = append(results, &configurationRecorderWithStatus{
+			Recorder: recorder,
+			Status:   statusByName[aws.StringValue(recorder.Name)],
+		})
+	}
+
+	return results, nil
+}
+
 func configGetOrganizationConfigRuleDetailedStatus(conn *configservice.ConfigService, ruleName, ruleStatus string) ([]*configservice.MemberAccountStatus, error) {
 	input := &configservice.GetOrganizationConfigRuleDetailedStatusInput{
 		Filters: &configservice.StatusDetailFilters{
@@ -221,12 +398,15 @@ func configGetOrganizationConfigRuleDetailedStatus(conn *configservice.ConfigSer
 
 func configGetOrganizationConformancePackDetailedStatus(conn *configservice.ConfigService, name, status string) ([]*configservice.OrganizationConformancePackDetailedStatus, error) {
 	input := &configservice.GetOrganizationConformancePackDetailedStatusInput{
-		Filters: &configservice.OrganizationResourceDetailedStatusFilters{
-			Status: aws.String(status),
-		},
 		OrganizationConformancePackName: aws.String(name),
 	}
 
+	if status != "" {
+		input.Filters = &configservice.OrganizationResourceDetailedStatusFilters{
+			Status: aws.String(status),
+		}
+	}
+
 	var statuses []*configservice.OrganizationConformancePackDetailedStatus
 
 	for {
@@ -248,6 +428,22 @@ func configGetOrganizationConformancePackDetailedStatus(conn *configservice.Conf
 	return statuses, nil
 }
 
+func configRefreshConfigRuleState(conn *configservice.ConfigService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rule, err := configDescribeConfigRule(conn, name)
+
+		if err != nil {
+			return nil, ConfigRuleStateUnknown, err
+		}
+
+		if rule == nil {
+			return "", ConfigRuleStateNotFound, nil
+		}
+
+		return rule, aws.StringValue(rule.ConfigRuleState), nil
+	}
+}
+
 func configRefreshConformancePackStatus(conn *configservice.ConfigService, name string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		status, err := configDescribeConformancePackStatus(conn, name)
@@ -260,10 +456,6 @@ func configRefreshConformancePackStatus(conn *configservice.ConfigService, name
 			return nil, ConfigConformancePackStatusNotFound, nil
 		}
 
-		if errMsg := aws.StringValue(status.ConformancePackStatusReason); errMsg != "" {
-			return status, aws.StringValue(status.ConformancePackState), fmt.Errorf(errMsg)
-		}
-
 		return status, aws.StringValue(status.ConformancePackState), nil
 	}
 }
@@ -378,6 +570,19 @@ func configOrganizationConformancePackDetailedStatusError(conn *configservice.Co
 	return fmt.Errorf("Failed in %d account(s):\n\n%s", len(memberAccountStatuses), errBuilder.String())
 }
 
+func configWaitForConfigRuleStateActive(conn *configservice.ConfigService, name string, timeout time.Duration) error {
+	stateChangeConf := resource.StateChangeConf{
+		Pending: []string{ConfigRuleStateNotFound, configservice.ConfigRuleStateEvaluating},
+		Target:  []string{configservice.ConfigRuleStateActive},
+		Timeout: timeout,
+		Refresh: configRefreshConfigRuleState(conn, name),
+	}
+
+	_, err := stateChangeConf.WaitForState()
+
+	return err
+}
+
 func configWaitForConformancePackStateCreateComplete(conn *configservice.ConfigService, name string) error {
 	stateChangeConf := resource.StateChangeConf{
 		Pending: []string{configservice.ConformancePackStateCreateInProgress},
@@ -386,30 +591,43 @@ func configWaitForConformancePackStateCreateComplete(conn *configservice.ConfigS
 		Refresh: configRefreshConformancePackStatus(conn, name),
 	}
 
-	_, err := stateChangeConf.WaitForState()
+	outputRaw, err := stateChangeConf.WaitForState()
 
 	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConformancePackException) {
 		return nil
 	}
 
+	if output, ok := outputRaw.(*configservice.ConformancePackStatusDetail); ok {
+		if aws.StringValue(output.ConformancePackState) == configservice.ConformancePackStateCreateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConformancePackStatusReason)))
+		}
+	}
+
 	return err
 
 }
 
-func configWaitForConformancePackStateDeleteComplete(conn *configservice.ConfigService, name string) error {
+func configWaitForConformancePackStateDeleteComplete(conn *configservice.ConfigService, name string, timeout time.Duration) error {
 	stateChangeConf := resource.StateChangeConf{
 		Pending: []string{configservice.ConformancePackStateDeleteInProgress},
 		Target:  []string{},
-		Timeout: ConfigConformancePackDeleteTimeout,
+		Timeout: timeout,
 		Refresh: configRefreshConformancePackStatus(conn, name),
 	}
 
-	_, err := stateChangeConf.WaitForState()
+	outputRaw, err := stateChangeConf.WaitForState()
 
 	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConformancePackException) {
 		return nil
 	}
 
+	if output, ok := outputRaw.(*configservice.ConformancePackStatusDetail); ok {
+		// A pack stuck in DELETE_FAILED will not leave that state on its own.
+		if aws.StringValue(output.ConformancePackState) == configservice.ConformancePackStateDeleteFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConformancePackStatusReason)))
+		}
+	}
+
 	return err
 }
 
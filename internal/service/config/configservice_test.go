@@ -0,0 +1,295 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/stub"
+)
+
+func TestPutConfigurationRecorder(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Errors           []error
+		ExpectedRequests int
+		ExpectedErrCode  string
+	}{
+		{
+			Name:             "success",
+			ExpectedRequests: 1,
+		},
+		{
+			Name: "concurrent modification",
+			Errors: []error{
+				awserr.New(errCodeConcurrentModificationException, "concurrent modification", nil),
+				awserr.New(errCodeConcurrentModificationException, "concurrent modification", nil),
+			},
+			ExpectedRequests: 3,
+		},
+		{
+			Name: "other error",
+			Errors: []error{
+				awserr.New(configservice.ErrCodeInvalidRoleException, "invalid role", nil),
+			},
+			ExpectedRequests: 1,
+			ExpectedErrCode:  configservice.ErrCodeInvalidRoleException,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var requests int
+
+			conn := configservice.New(stub.Session(t))
+			stub.Send(conn.Client, func(r *request.Request) {
+				if requests < len(testCase.Errors) {
+					r.Error = testCase.Errors[requests]
+				}
+
+				requests++
+			})
+
+			input := &configservice.PutConfigurationRecorderInput{
+				ConfigurationRecorder: &configservice.ConfigurationRecorder{
+					Name: aws.String("example"),
+				},
+			}
+
+			err := putConfigurationRecorder(conn, input)
+
+			if testCase.ExpectedErrCode == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedErrCode != "" && !tfawserr.ErrCodeEquals(err, testCase.ExpectedErrCode) {
+				t.Fatalf("expected error code %s, got: %v", testCase.ExpectedErrCode, err)
+			}
+
+			if requests != testCase.ExpectedRequests {
+				t.Errorf("expected %d requests, got %d", testCase.ExpectedRequests, requests)
+			}
+		})
+	}
+}
+
+func TestPutDeliveryChannel(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Errors           []error
+		ExpectedRequests int
+		ExpectedErrCode  string
+	}{
+		{
+			Name:             "success",
+			ExpectedRequests: 1,
+		},
+		{
+			Name: "no configuration recorder yet",
+			Errors: []error{
+				awserr.New(configservice.ErrCodeNoAvailableConfigurationRecorderException, "Configuration recorder is not available to put delivery channel.", nil),
+				awserr.New(configservice.ErrCodeNoAvailableConfigurationRecorderException, "Configuration recorder is not available to put delivery channel.", nil),
+			},
+			ExpectedRequests: 3,
+		},
+		{
+			Name: "insufficient delivery policy",
+			Errors: []error{
+				awserr.New(configservice.ErrCodeInsufficientDeliveryPolicyException, "insufficient delivery policy", nil),
+			},
+			ExpectedRequests: 2,
+		},
+		{
+			Name: "other error",
+			Errors: []error{
+				awserr.New(configservice.ErrCodeNoSuchBucketException, "no such bucket", nil),
+			},
+			ExpectedRequests: 1,
+			ExpectedErrCode:  configservice.ErrCodeNoSuchBucketException,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var requests int
+
+			conn := configservice.New(stub.Session(t))
+			stub.Send(conn.Client, func(r *request.Request) {
+				if requests < len(testCase.Errors) {
+					r.Error = testCase.Errors[requests]
+				}
+
+				requests++
+			})
+
+			input := &configservice.PutDeliveryChannelInput{
+				DeliveryChannel: &configservice.DeliveryChannel{
+					Name:         aws.String("example"),
+					S3BucketName: aws.String("example"),
+				},
+			}
+
+			err := putDeliveryChannel(conn, input)
+
+			if testCase.ExpectedErrCode == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedErrCode != "" && !tfawserr.ErrCodeEquals(err, testCase.ExpectedErrCode) {
+				t.Fatalf("expected error code %s, got: %v", testCase.ExpectedErrCode, err)
+			}
+
+			if requests != testCase.ExpectedRequests {
+				t.Errorf("expected %d requests, got %d", testCase.ExpectedRequests, requests)
+			}
+		})
+	}
+}
+
+func TestConfigWaitForConformancePackStateDeleteComplete(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		States        []*configservice.ConformancePackStatusDetail
+		ExpectedError string
+	}{
+		{
+			Name: "deleted",
+			States: []*configservice.ConformancePackStatusDetail{
+				{
+					ConformancePackName:  aws.String("example"),
+					ConformancePackState: aws.String(configservice.ConformancePackStateDeleteInProgress),
+				},
+				nil,
+			},
+		},
+		{
+			Name: "delete failed",
+			States: []*configservice.ConformancePackStatusDetail{
+				{
+					ConformancePackName:  aws.String("example"),
+					ConformancePackState: aws.String(configservice.ConformancePackStateDeleteInProgress),
+				},
+				{
+					ConformancePackName:         aws.String("example"),
+					ConformancePackState:        aws.String(configservice.ConformancePackStateDeleteFailed),
+					ConformancePackStatusReason: aws.String("stack deletion failed"),
+				},
+			},
+			ExpectedError: "stack deletion failed",
+		},
+		{
+			Name: "stuck in progress",
+			States: []*configservice.ConformancePackStatusDetail{
+				{
+					ConformancePackName:  aws.String("example"),
+					ConformancePackState: aws.String(configservice.ConformancePackStateDeleteInProgress),
+				},
+			},
+			ExpectedError: "last state: 'DELETE_IN_PROGRESS'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var requests int
+
+			conn := configservice.New(stub.Session(t))
+			stub.Send(conn.Client, func(r *request.Request) {
+				// Repeat the last state once the sequence is exhausted.
+				status := testCase.States[len(testCase.States)-1]
+				if requests < len(testCase.States) {
+					status = testCase.States[requests]
+				}
+
+				output := r.Data.(*configservice.DescribeConformancePackStatusOutput)
+				if status != nil {
+					output.ConformancePackStatusDetails = []*configservice.ConformancePackStatusDetail{status}
+				}
+
+				requests++
+			})
+
+			err := configWaitForConformancePackStateDeleteComplete(conn, "example", 2*time.Second)
+
+			if testCase.ExpectedError == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedError != "" && (err == nil || !strings.Contains(err.Error(), testCase.ExpectedError)) {
+				t.Fatalf("expected error containing %q, got: %v", testCase.ExpectedError, err)
+			}
+		})
+	}
+}
+
+func TestConfigWaitForConfigRuleStateActive(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		States           []string
+		ExpectedRequests int
+		ExpectedError    string
+	}{
+		{
+			Name:             "evaluating then active",
+			States:           []string{"", configservice.ConfigRuleStateEvaluating, configservice.ConfigRuleStateActive},
+			ExpectedRequests: 3,
+		},
+		{
+			Name:          "stuck evaluating",
+			States:        []string{configservice.ConfigRuleStateEvaluating},
+			ExpectedError: "last state: 'EVALUATING'",
+		},
+		{
+			Name:          "deleting",
+			States:        []string{configservice.ConfigRuleStateEvaluating, configservice.ConfigRuleStateDeleting},
+			ExpectedError: "unexpected state 'DELETING'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var requests int
+
+			conn := configservice.New(stub.Session(t))
+			stub.Send(conn.Client, func(r *request.Request) {
+				// Repeat the last state once the sequence is exhausted.
+				state := testCase.States[len(testCase.States)-1]
+				if requests < len(testCase.States) {
+					state = testCase.States[requests]
+				}
+
+				// An empty state indicates that the rule is not yet visible.
+				output := r.Data.(*configservice.DescribeConfigRulesOutput)
+				if state != "" {
+					output.ConfigRules = []*configservice.ConfigRule{
+						{
+							ConfigRuleName:  aws.String("example"),
+							ConfigRuleState: aws.String(state),
+						},
+					}
+				}
+
+				requests++
+			})
+
+			err := configWaitForConfigRuleStateActive(conn, "example", 2*time.Second)
+
+			if testCase.ExpectedError == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedError != "" && (err == nil || !strings.Contains(err.Error(), testCase.ExpectedError)) {
+				t.Fatalf("expected error containing %q, got: %v", testCase.ExpectedError, err)
+			}
+
+			if testCase.ExpectedRequests != 0 && requests != testCase.ExpectedRequests {
+				t.Errorf("expected %d requests, got %d", testCase.ExpectedRequests, requests)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceConfigurationAggregatorCompliance() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceConfigurationAggregatorComplianceRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"aws_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"compliance_by_config_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"aws_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compliance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"config_rule_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"non_compliant_resource_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"non_compliant_resource_count_capped": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"configuration_aggregator_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+		},
+	}
+}
+
+func dataSourceConfigurationAggregatorComplianceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ConfigConn
+
+	name := d.Get("configuration_aggregator_name").(string)
+	input := &configservice.DescribeAggregateComplianceByConfigRulesInput{
+		ConfigurationAggregatorName: aws.String(name),
+	}
+
+	filters := &configservice.ConfigRuleComplianceFilters{}
+	if v, ok := d.GetOk("account_id"); ok {
+		filters.AccountId = aws.String(v.(string))
+		input.Filters = filters
+	}
+	if v, ok := d.GetOk("aws_region"); ok {
+		filters.AwsRegion = aws.String(v.(string))
+		input.Filters = filters
+	}
+
+	compliance, err := describeAggregateComplianceByConfigRules(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error reading Config Configuration Aggregator (%s) compliance: %w", name, err)
+	}
+
+	sort.Slice(compliance, func(i, j int) bool {
+		if v1, v2 := aws.StringValue(compliance[i].ConfigRuleName), aws.StringValue(compliance[j].ConfigRuleName); v1 != v2 {
+			return v1 < v2
+		}
+
+		if v1, v2 := aws.StringValue(compliance[i].AccountId), aws.StringValue(compliance[j].AccountId); v1 != v2 {
+			return v1 < v2
+		}
+
+		return aws.StringValue(compliance[i].AwsRegion) < aws.StringValue(compliance[j].AwsRegion)
+	})
+
+	d.SetId(name)
+
+	if err := d.Set("compliance_by_config_rules", flattenAggregateComplianceByConfigRules(compliance)); err != nil {
+		return fmt.Errorf("error setting compliance_by_config_rules: %w", err)
+	}
+
+	return nil
+}
+
+func flattenAggregateComplianceByConfigRules(apiObjects []*configservice.AggregateComplianceByConfigRule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"account_id":       aws.StringValue(apiObject.AccountId),
+			"aws_region":       aws.StringValue(apiObject.AwsRegion),
+			"config_rule_name": aws.StringValue(apiObject.ConfigRuleName),
+		}
+
+		if v := apiObject.Compliance; v != nil {
+			tfMap["compliance_type"] = aws.StringValue(v.ComplianceType)
+
+			if v := v.ComplianceContributorCount; v != nil {
+				tfMap["non_compliant_resource_count"] = aws.Int64Value(v.CappedCount)
+				tfMap["non_compliant_resource_count_capped"] = aws.BoolValue(v.CapExceeded)
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,72 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccConfigConfigurationAggregatorComplianceDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_config_configuration_aggregator_compliance.test"
+	resourceName := "aws_config_configuration_aggregator.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, configservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigurationAggregatorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationAggregatorComplianceDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "configuration_aggregator_name", resourceName, "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "compliance_by_config_rules.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConfigConfigurationAggregatorComplianceDataSource_filters(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_config_configuration_aggregator_compliance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, configservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigurationAggregatorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationAggregatorComplianceDataSourceFiltersConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "aws_region", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "compliance_by_config_rules.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigurationAggregatorComplianceDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccConfigurationAggregatorConfig_account(rName), `
+data "aws_config_configuration_aggregator_compliance" "test" {
+  configuration_aggregator_name = aws_config_configuration_aggregator.test.name
+}
+`)
+}
+
+func testAccConfigurationAggregatorComplianceDataSourceFiltersConfig(rName string) string {
+	return acctest.ConfigCompose(testAccConfigurationAggregatorConfig_account(rName), `
+data "aws_config_configuration_aggregator_compliance" "test" {
+  configuration_aggregator_name = aws_config_configuration_aggregator.test.name
+  account_id                    = data.aws_caller_identity.current.account_id
+  aws_region                    = data.aws_region.current.name
+}
+`)
+}
@@ -1,8 +1,11 @@
 package config
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
@@ -10,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceConfigurationRecorder() *schema.Resource {
@@ -25,6 +27,24 @@ func ResourceConfigurationRecorder() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"effective_global_resource_types": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"global_resource_types_home_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`), "must be a valid AWS Region name, e.g. us-east-1"),
+			},
+			"global_resource_types_outside_home_region": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"last_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -32,10 +52,14 @@ func ResourceConfigurationRecorder() *schema.Resource {
 				Default:      "default",
 				ValidateFunc: validation.StringLenBetween(0, 256),
 			},
+			"recording": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: validRoleARN,
 			},
 			"recording_group": {
 				Type:     schema.TypeList,
@@ -45,24 +69,39 @@ func ResourceConfigurationRecorder() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"all_supported": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  true,
+							Type:          schema.TypeBool,
+							Optional:      true,
+							Default:       true,
+							ConflictsWith: []string{"recording_group.0.global_resources_only"},
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								// global_resources_only takes precedence over all_supported.
+								return d.Get("recording_group.0.global_resources_only").(bool)
+							},
+						},
+						"global_resources_only": {
+							Type:          schema.TypeBool,
+							Optional:      true,
+							Default:       false,
+							ConflictsWith: []string{"recording_group.0.all_supported", "recording_group.0.include_global_resource_types", "recording_group.0.resource_types"},
 						},
 						"include_global_resource_types": {
-							Type:     schema.TypeBool,
-							Optional: true,
+							Type:          schema.TypeBool,
+							Optional:      true,
+							ConflictsWith: []string{"recording_group.0.global_resources_only"},
 						},
 						"resource_types": {
-							Type:     schema.TypeSet,
-							Set:      schema.HashString,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Type:          schema.TypeSet,
+							Set:           schema.HashString,
+							Optional:      true,
+							Elem:          &schema.Schema{Type: schema.TypeString},
+							ConflictsWith: []string{"recording_group.0.global_resources_only"},
 						},
 					},
 				},
 			},
 		},
+
+		CustomizeDiff: resourceConfigurationRecorderCustomizeDiff,
 	}
 }
 
@@ -71,18 +110,15 @@ func resourceConfigurationRecorderPut(d *schema.ResourceData, meta interface{})
 
 	name := d.Get("name").(string)
 	recorder := configservice.ConfigurationRecorder{
-		Name:    aws.String(name),
-		RoleARN: aws.String(d.Get("role_arn").(string)),
-	}
-
-	if g, ok := d.GetOk("recording_group"); ok {
-		recorder.RecordingGroup = expandRecordingGroup(g.([]interface{}))
+		Name:           aws.String(name),
+		RecordingGroup: expandRecordingGroup(d.Get("recording_group").([]interface{})),
+		RoleARN:        aws.String(d.Get("role_arn").(string)),
 	}
 
 	input := configservice.PutConfigurationRecorderInput{
 		ConfigurationRecorder: &recorder,
 	}
-	_, err := conn.PutConfigurationRecorder(&input)
+	err := putConfigurationRecorder(conn, &input)
 	if err != nil {
 		return fmt.Errorf("Creating Configuration Recorder failed: %s", err)
 	}
@@ -126,13 +162,43 @@ func resourceConfigurationRecorderRead(d *schema.ResourceData, meta interface{})
 	d.Set("role_arn", recorder.RoleARN)
 
 	if recorder.RecordingGroup != nil {
-		flattened := flattenRecordingGroup(recorder.RecordingGroup)
+		flattened := flattenRecordingGroup(recorder.RecordingGroup, d.Get("recording_group.0.global_resources_only").(bool))
 		err = d.Set("recording_group", flattened)
 		if err != nil {
 			return fmt.Errorf("Failed to set recording_group: %s", err)
 		}
 	}
 
+	if err := d.Set("effective_global_resource_types", effectiveGlobalResourceTypes(recorder.RecordingGroup)); err != nil {
+		return fmt.Errorf("error setting effective_global_resource_types: %w", err)
+	}
+
+	region := meta.(*conns.AWSClient).Region
+	d.Set("global_resource_types_outside_home_region", globalResourceTypesOutsideHomeRegion(recorder.RecordingGroup, region, d.Get("global_resource_types_home_region").(string)))
+
+	// Recording is started and stopped by aws_config_configuration_recorder_status;
+	// the status is reported here for visibility only.
+	statusOut, err := conn.DescribeConfigurationRecorderStatus(&configservice.DescribeConfigurationRecorderStatusInput{
+		ConfigurationRecorderNames: []*string{aws.String(d.Id())},
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConfigurationRecorderException) {
+		return fmt.Errorf("error describing Configuration Recorder (%s) status: %w", d.Id(), err)
+	}
+
+	var status *configservice.ConfigurationRecorderStatus
+	if statusOut != nil && len(statusOut.ConfigurationRecordersStatus) > 0 {
+		status = statusOut.ConfigurationRecordersStatus[0]
+	}
+
+	if status != nil {
+		d.Set("last_status", status.LastStatus)
+		d.Set("recording", status.Recording)
+	} else {
+		d.Set("last_status", nil)
+		d.Set("recording", false)
+	}
+
 	return nil
 }
 
@@ -149,3 +215,95 @@ func resourceConfigurationRecorderDelete(d *schema.ResourceData, meta interface{
 	}
 	return nil
 }
+
+func resourceConfigurationRecorderCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("global_resource_types_home_region") || !diff.NewValueKnown("recording_group") {
+		return nil
+	}
+
+	region := meta.(*conns.AWSClient).Region
+	homeRegion := diff.Get("global_resource_types_home_region").(string)
+	outside := globalResourceTypesOutsideHomeRegion(expandRecordingGroup(diff.Get("recording_group").([]interface{})), region, homeRegion)
+
+	if !diff.NewValueKnown("global_resource_types_outside_home_region") || diff.Get("global_resource_types_outside_home_region").(bool) != outside {
+		return diff.SetNew("global_resource_types_outside_home_region", outside)
+	}
+
+	return nil
+}
+
+// globalResourceTypesOutsideHomeRegion returns whether the specified recording group records global resource types
+// in a Region other than the configured home Region for global resource recording.
+func globalResourceTypesOutsideHomeRegion(g *configservice.RecordingGroup, region, homeRegion string) bool {
+	if homeRegion == "" || region == homeRegion {
+		return false
+	}
+
+	return len(effectiveGlobalResourceTypes(g)) > 0
+}
+
+// globalResourceTypes returns the resource types that AWS Config records only once per account,
+// in the Region where global resource recording is enabled. These are the IAM resource types,
+// taken from the API model so that types added by AWS are picked up with SDK updates.
+func globalResourceTypes() []string {
+	var types []string
+
+	for _, v := range configservice.ResourceType_Values() {
+		if strings.HasPrefix(v, "AWS::IAM::") {
+			types = append(types, v)
+		}
+	}
+
+	return types
+}
+
+func isGlobalResourceType(resourceType string) bool {
+	for _, v := range globalResourceTypes() {
+		if v == resourceType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isGlobalResourcesOnlyRecordingGroup returns whether the specified recording group records
+// only global resource types, as configured by global_resources_only.
+func isGlobalResourcesOnlyRecordingGroup(g *configservice.RecordingGroup) bool {
+	if g == nil || aws.BoolValue(g.AllSupported) || len(g.ResourceTypes) == 0 {
+		return false
+	}
+
+	for _, v := range g.ResourceTypes {
+		if !isGlobalResourceType(aws.StringValue(v)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// effectiveGlobalResourceTypes returns the global resource types that the specified recording group records.
+func effectiveGlobalResourceTypes(g *configservice.RecordingGroup) []string {
+	if g == nil {
+		return nil
+	}
+
+	if aws.BoolValue(g.AllSupported) {
+		if aws.BoolValue(g.IncludeGlobalResourceTypes) {
+			return globalResourceTypes()
+		}
+
+		return nil
+	}
+
+	var types []string
+
+	for _, v := range g.ResourceTypes {
+		if v := aws.StringValue(v); isGlobalResourceType(v) {
+			types = append(types, v)
+		}
+	}
+
+	return types
+}
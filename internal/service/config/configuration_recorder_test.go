@@ -1,18 +1,258 @@
 package config_test
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/configservice"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/stub"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconfig "github.com/hashicorp/terraform-provider-aws/internal/service/config"
 )
 
+func TestConfigurationRecorderCustomizeDiff_globalResourceTypesOutsideHomeRegion(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		Region         string
+		HomeRegion     string
+		RecordingGroup map[string]interface{}
+		Expected       bool
+	}{
+		{
+			Name:   "no home region",
+			Region: "us-west-2", //lintignore:AWSAT003
+			RecordingGroup: map[string]interface{}{
+				"include_global_resource_types": true,
+			},
+		},
+		{
+			Name:       "home region",
+			Region:     "us-east-1", //lintignore:AWSAT003
+			HomeRegion: "us-east-1", //lintignore:AWSAT003
+			RecordingGroup: map[string]interface{}{
+				"include_global_resource_types": true,
+			},
+		},
+		{
+			Name:       "other region including global resource types",
+			Region:     "us-west-2", //lintignore:AWSAT003
+			HomeRegion: "us-east-1", //lintignore:AWSAT003
+			RecordingGroup: map[string]interface{}{
+				"include_global_resource_types": true,
+			},
+			Expected: true,
+		},
+		{
+			Name:       "other region recording global resources only",
+			Region:     "us-west-2", //lintignore:AWSAT003
+			HomeRegion: "us-east-1", //lintignore:AWSAT003
+			RecordingGroup: map[string]interface{}{
+				"global_resources_only": true,
+			},
+			Expected: true,
+		},
+		{
+			Name:       "other region excluding global resource types",
+			Region:     "us-west-2", //lintignore:AWSAT003
+			HomeRegion: "us-east-1", //lintignore:AWSAT003
+			RecordingGroup: map[string]interface{}{
+				"include_global_resource_types": false,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"role_arn":        "arn:aws:iam::123456789012:role/config-role", //lintignore:AWSAT005
+				"recording_group": []interface{}{testCase.RecordingGroup},
+			}
+
+			if testCase.HomeRegion != "" {
+				raw["global_resource_types_home_region"] = testCase.HomeRegion
+			}
+
+			diff, err := tfconfig.ResourceConfigurationRecorder().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), &conns.AWSClient{Region: testCase.Region})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			attr, ok := diff.Attributes["global_resource_types_outside_home_region"]
+
+			if !ok {
+				t.Fatal("expected global_resource_types_outside_home_region in plan")
+			}
+
+			if got, expected := attr.New, fmt.Sprint(testCase.Expected); got != expected {
+				t.Errorf("got global_resource_types_outside_home_region %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestConfigurationRecorderValidate_globalResourcesOnly(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		RecordingGroup map[string]interface{}
+		ExpectedError  *regexp.Regexp
+	}{
+		{
+			Name: "global resources only",
+			RecordingGroup: map[string]interface{}{
+				"global_resources_only": true,
+			},
+		},
+		{
+			Name: "all_supported",
+			RecordingGroup: map[string]interface{}{
+				"all_supported":         true,
+				"global_resources_only": true,
+			},
+			ExpectedError: regexp.MustCompile(`conflicts with recording_group.0.all_supported`),
+		},
+		{
+			Name: "include_global_resource_types",
+			RecordingGroup: map[string]interface{}{
+				"global_resources_only":         true,
+				"include_global_resource_types": true,
+			},
+			ExpectedError: regexp.MustCompile(`conflicts with recording_group.0.include_global_resource_types`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			diags := tfconfig.ResourceConfigurationRecorder().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+				"role_arn":        "arn:aws:iam::123456789012:role/config-role", //lintignore:AWSAT005
+				"recording_group": []interface{}{testCase.RecordingGroup},
+			}))
+
+			if testCase.ExpectedError == nil {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+
+				return
+			}
+
+			var matched bool
+
+			for _, d := range diags {
+				if testCase.ExpectedError.MatchString(d.Summary) || testCase.ExpectedError.MatchString(d.Detail) {
+					matched = true
+				}
+			}
+
+			if !matched {
+				t.Errorf("expected error matching %s, got: %v", testCase.ExpectedError, diags)
+			}
+		})
+	}
+}
+
+func TestConfigurationRecorderRead_status(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		Status             *configservice.ConfigurationRecorderStatus
+		ExpectedRecording  string
+		ExpectedLastStatus string
+	}{
+		{
+			Name: "started",
+			Status: &configservice.ConfigurationRecorderStatus{
+				LastStatus: aws.String(configservice.RecorderStatusSuccess),
+				Name:       aws.String("test"),
+				Recording:  aws.Bool(true),
+			},
+			ExpectedRecording:  "true",
+			ExpectedLastStatus: configservice.RecorderStatusSuccess,
+		},
+		{
+			Name: "never started",
+			Status: &configservice.ConfigurationRecorderStatus{
+				Name:      aws.String("test"),
+				Recording: aws.Bool(false),
+			},
+			ExpectedRecording: "false",
+		},
+		{
+			Name:              "no status",
+			ExpectedRecording: "false",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := configservice.New(stub.Session(t))
+			stub.Send(conn.Client, func(r *request.Request) {
+				switch output := r.Data.(type) {
+				case *configservice.DescribeConfigurationRecordersOutput:
+					output.ConfigurationRecorders = []*configservice.ConfigurationRecorder{{
+						Name:    aws.String("test"),
+						RoleARN: aws.String("arn:aws:iam::123456789012:role/test"), //lintignore:AWSAT005
+					}}
+				case *configservice.DescribeConfigurationRecorderStatusOutput:
+					if testCase.Status != nil {
+						output.ConfigurationRecordersStatus = []*configservice.ConfigurationRecorderStatus{testCase.Status}
+					}
+				}
+			})
+
+			r := tfconfig.ResourceConfigurationRecorder()
+			d := r.Data(nil)
+			d.SetId("test")
+
+			if err := r.Read(d, &conns.AWSClient{ConfigConn: conn, Region: "us-west-2"}); err != nil { //lintignore:AWSAT003
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			attributes := d.State().Attributes
+
+			if got, expected := attributes["recording"], testCase.ExpectedRecording; got != expected {
+				t.Errorf("got recording %q, expected %q", got, expected)
+			}
+
+			if got, expected := attributes["last_status"], testCase.ExpectedLastStatus; got != expected {
+				t.Errorf("got last_status %q, expected %q", got, expected)
+			}
+		})
+	}
+}
+
+func testAccConfigConfigurationRecorder_status(t *testing.T) {
+	resourceName := "aws_config_configuration_recorder.foo"
+	rInt := sdkacctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, configservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigConfigurationRecorderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfigurationRecorderStatusConfig(rInt, true),
+			},
+			{
+				// The recorder is started after it is created, so its status is visible from the next refresh.
+				Config: testAccConfigConfigurationRecorderStatusConfig(rInt, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "recording", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_status"),
+				),
+			},
+		},
+	})
+}
+
 func testAccConfigConfigurationRecorder_basic(t *testing.T) {
 	var cr configservice.ConfigurationRecorder
 	rInt := sdkacctest.RandInt()
@@ -33,6 +273,7 @@ func testAccConfigConfigurationRecorder_basic(t *testing.T) {
 					testAccCheckConfigConfigurationRecorderExists(resourceName, &cr),
 					testAccCheckConfigConfigurationRecorderName(resourceName, expectedName, &cr),
 					acctest.CheckResourceAttrGlobalARN(resourceName, "role_arn", "iam", fmt.Sprintf("role/%s", expectedRoleName)),
+					resource.TestCheckResourceAttr(resourceName, "effective_global_resource_types.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "name", expectedName),
 				),
 			},
@@ -40,6 +281,36 @@ func testAccConfigConfigurationRecorder_basic(t *testing.T) {
 	})
 }
 
+func testAccConfigConfigurationRecorder_noRecordingGroup(t *testing.T) {
+	var cr configservice.ConfigurationRecorder
+	rInt := sdkacctest.RandInt()
+
+	resourceName := "aws_config_configuration_recorder.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, configservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigConfigurationRecorderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfigurationRecorderConfig_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigConfigurationRecorderExists(resourceName, &cr),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.0.all_supported", "true"),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.0.include_global_resource_types", "false"),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.0.resource_types.#", "0"),
+				),
+			},
+			{
+				Config:   testAccConfigConfigurationRecorderConfig_basic(rInt),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccConfigConfigurationRecorder_allParams(t *testing.T) {
 	var cr configservice.ConfigurationRecorder
 	rInt := sdkacctest.RandInt()
@@ -60,6 +331,7 @@ func testAccConfigConfigurationRecorder_allParams(t *testing.T) {
 					testAccCheckConfigConfigurationRecorderExists(resourceName, &cr),
 					testAccCheckConfigConfigurationRecorderName(resourceName, expectedName, &cr),
 					acctest.CheckResourceAttrGlobalARN(resourceName, "role_arn", "iam", fmt.Sprintf("role/%s", expectedRoleName)),
+					resource.TestCheckResourceAttr(resourceName, "effective_global_resource_types.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "name", expectedName),
 					resource.TestCheckResourceAttr(resourceName, "recording_group.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "recording_group.0.all_supported", "false"),
@@ -71,6 +343,46 @@ func testAccConfigConfigurationRecorder_allParams(t *testing.T) {
 	})
 }
 
+func testAccConfigConfigurationRecorder_globalResourcesOnly(t *testing.T) {
+	var cr configservice.ConfigurationRecorder
+	rInt := sdkacctest.RandInt()
+	resourceName := "aws_config_configuration_recorder.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, configservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigConfigurationRecorderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfigConfigurationRecorderConfig_globalResourcesOnlyResourceTypes(rInt),
+				ExpectError: regexp.MustCompile(`"recording_group.0.global_resources_only": conflicts with recording_group.0.resource_types`),
+			},
+			{
+				Config: testAccConfigConfigurationRecorderConfig_globalResourcesOnly(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigConfigurationRecorderExists(resourceName, &cr),
+					resource.TestCheckResourceAttr(resourceName, "effective_global_resource_types.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.0.all_supported", "false"),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.0.global_resources_only", "true"),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.0.include_global_resource_types", "false"),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.0.resource_types.#", "0"),
+				),
+			},
+			{
+				Config: testAccConfigConfigurationRecorderConfig_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigConfigurationRecorderExists(resourceName, &cr),
+					resource.TestCheckResourceAttr(resourceName, "effective_global_resource_types.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.0.all_supported", "true"),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.0.global_resources_only", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccConfigConfigurationRecorder_importBasic(t *testing.T) {
 	resourceName := "aws_config_configuration_recorder.foo"
 	rInt := sdkacctest.RandInt()
@@ -293,3 +605,89 @@ resource "aws_config_delivery_channel" "foo" {
 }
 `, randInt, randInt, randInt, randInt, randInt)
 }
+
+func testAccConfigConfigurationRecorderConfig_globalResourcesOnlyBase(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "r" {
+  name = "tf-acc-test-awsconfig-%[1]d"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "config.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "p" {
+  name = "tf-acc-test-awsconfig-%[1]d"
+  role = aws_iam_role.r.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": [
+        "s3:*"
+      ],
+      "Effect": "Allow",
+      "Resource": [
+        "${aws_s3_bucket.b.arn}",
+        "${aws_s3_bucket.b.arn}/*"
+      ]
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_s3_bucket" "b" {
+  bucket        = "tf-acc-test-awsconfig-%[1]d"
+  force_destroy = true
+}
+
+resource "aws_config_delivery_channel" "foo" {
+  name           = "tf-acc-test-awsconfig-%[1]d"
+  s3_bucket_name = aws_s3_bucket.b.bucket
+  depends_on     = [aws_config_configuration_recorder.foo]
+}
+`, randInt)
+}
+
+func testAccConfigConfigurationRecorderConfig_globalResourcesOnly(randInt int) string {
+	return acctest.ConfigCompose(testAccConfigConfigurationRecorderConfig_globalResourcesOnlyBase(randInt), fmt.Sprintf(`
+resource "aws_config_configuration_recorder" "foo" {
+  name     = "tf-acc-test-%[1]d"
+  role_arn = aws_iam_role.r.arn
+
+  recording_group {
+    global_resources_only = true
+  }
+}
+`, randInt))
+}
+
+func testAccConfigConfigurationRecorderConfig_globalResourcesOnlyResourceTypes(randInt int) string {
+	return acctest.ConfigCompose(testAccConfigConfigurationRecorderConfig_globalResourcesOnlyBase(randInt), fmt.Sprintf(`
+resource "aws_config_configuration_recorder" "foo" {
+  name     = "tf-acc-test-%[1]d"
+  role_arn = aws_iam_role.r.arn
+
+  recording_group {
+    global_resources_only = true
+    all_supported         = false
+    resource_types        = ["AWS::IAM::Role"]
+  }
+}
+`, randInt))
+}
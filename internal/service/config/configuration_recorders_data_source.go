@@ -0,0 +1,104 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceConfigurationRecorders() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceConfigurationRecordersRead,
+
+		Schema: map[string]*schema.Schema{
+			"names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"recorders": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"recording": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"role_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceConfigurationRecordersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ConfigConn
+
+	var names []*string
+	if v, ok := d.GetOk("names"); ok && v.(*schema.Set).Len() > 0 {
+		names = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	recorders, err := describeConfigurationRecordersWithStatus(conn, names)
+
+	if err != nil {
+		return fmt.Errorf("error reading Config Configuration Recorders: %w", err)
+	}
+
+	sort.Slice(recorders, func(i, j int) bool {
+		return aws.StringValue(recorders[i].Recorder.Name) < aws.StringValue(recorders[j].Recorder.Name)
+	})
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("recorders", flattenConfigurationRecordersWithStatus(recorders)); err != nil {
+		return fmt.Errorf("error setting recorders: %w", err)
+	}
+
+	return nil
+}
+
+func flattenConfigurationRecordersWithStatus(apiObjects []*configurationRecorderWithStatus) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.Recorder == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name":     aws.StringValue(apiObject.Recorder.Name),
+			"role_arn": aws.StringValue(apiObject.Recorder.RoleARN),
+		}
+
+		if v := apiObject.Status; v != nil {
+			tfMap["last_status"] = aws.StringValue(v.LastStatus)
+			tfMap["recording"] = aws.BoolValue(v.Recording)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
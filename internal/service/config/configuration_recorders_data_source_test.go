@@ -0,0 +1,44 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccConfigConfigurationRecordersDataSource_basic(t *testing.T) {
+	rInt := sdkacctest.RandInt()
+	dataSourceName := "data.aws_config_configuration_recorders.test"
+	resourceName := "aws_config_configuration_recorder.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, configservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigConfigurationRecorderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfigurationRecordersDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "recorders.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "recorders.0.name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "recorders.0.role_arn", resourceName, "role_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "recorders.0.recording", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigConfigurationRecordersDataSourceConfig(randInt int) string {
+	return acctest.ConfigCompose(
+		testAccConfigConfigurationRecorderConfig_basic(randInt),
+		`
+data "aws_config_configuration_recorders" "test" {
+  names = [aws_config_configuration_recorder.foo.name]
+}
+`)
+}
@@ -27,6 +27,10 @@ func ResourceConformancePack() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(ConfigConformancePackDeleteTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -77,6 +81,7 @@ func ResourceConformancePack() *schema.Resource {
 				ValidateFunc: validation.All(
 					validation.StringLenBetween(1, 51200),
 					verify.ValidStringIsJSONOrYAML,
+					validConformancePackTemplateBody,
 				),
 				AtLeastOneOf: []string{"template_body", "template_s3_uri"},
 			},
@@ -180,7 +185,7 @@ func resourceConformancePackDelete(d *schema.ResourceData, meta interface{}) err
 		ConformancePackName: aws.String(d.Id()),
 	}
 
-	err := resource.Retry(ConfigConformancePackDeleteTimeout, func() *resource.RetryError {
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, err := conn.DeleteConformancePack(input)
 
 		if err != nil {
@@ -206,7 +211,7 @@ func resourceConformancePackDelete(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("erorr deleting Config Conformance Pack (%s): %w", d.Id(), err)
 	}
 
-	if err := configWaitForConformancePackStateDeleteComplete(conn, d.Id()); err != nil {
+	if err := configWaitForConformancePackStateDeleteComplete(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return fmt.Errorf("error waiting for Config Conformance Pack (%s) to be deleted: %w", d.Id(), err)
 	}
 
@@ -3,6 +3,7 @@ package config_test
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -151,6 +152,32 @@ func testAccConfigConformancePack_inputParameters(t *testing.T) {
 	})
 }
 
+func testAccConfigConformancePack_inputParametersOrder(t *testing.T) {
+	var pack configservice.ConformancePackDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_config_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, configservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConformancePackInputParametersOrderConfig(rName, "TestKey1", "TestKey2", "TestKey3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigConformancePackExists(resourceName, &pack),
+					resource.TestCheckResourceAttr(resourceName, "input_parameter.#", "3"),
+				),
+			},
+			{
+				Config:   testAccConfigConformancePackInputParametersOrderConfig(rName, "TestKey3", "TestKey1", "TestKey2"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccConfigConformancePack_S3Delivery(t *testing.T) {
 	var pack configservice.ConformancePackDetail
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -620,6 +647,42 @@ EOT
 `, rName, pName1, pName2))
 }
 
+func testAccConfigConformancePackInputParametersOrderConfig(rName string, pNames ...string) string {
+	var inputParameters, templateParameters strings.Builder
+
+	for _, pName := range pNames {
+		fmt.Fprintf(&inputParameters, `
+  input_parameter {
+    parameter_name  = %[1]q
+    parameter_value = "%[1]sValue"
+  }
+`, pName)
+		fmt.Fprintf(&templateParameters, `
+  %[1]s:
+    Type: String`, pName)
+	}
+
+	return acctest.ConfigCompose(testAccConfigConformancePackConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_config_conformance_pack" "test" {
+  depends_on = [aws_config_configuration_recorder.test]
+  name       = %[1]q
+%[2]s
+  template_body = <<EOT
+Parameters:%[3]s
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+EOT
+}
+`, rName, inputParameters.String(), templateParameters.String()))
+}
+
 func testAccConfigConformancePackS3DeliveryConfig(rName, bucketName string) string {
 	return acctest.ConfigCompose(testAccConfigConformancePackConfigBase(rName),
 		fmt.Sprintf(`
@@ -13,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -96,31 +95,16 @@ func resourceDeliveryChannelPut(d *schema.ResourceData, meta interface{}) error
 		propertiesBlocks := p.([]interface{})
 		block := propertiesBlocks[0].(map[string]interface{})
 
-		if v, ok := block["delivery_frequency"]; ok {
+		if v, ok := block["delivery_frequency"].(string); ok && v != "" {
 			channel.ConfigSnapshotDeliveryProperties = &configservice.ConfigSnapshotDeliveryProperties{
-				DeliveryFrequency: aws.String(v.(string)),
+				DeliveryFrequency: aws.String(v),
 			}
 		}
 	}
 
 	input := configservice.PutDeliveryChannelInput{DeliveryChannel: &channel}
 
-	err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
-		_, err := conn.PutDeliveryChannel(&input)
-		if err == nil {
-			return nil
-		}
-
-		if tfawserr.ErrMessageContains(err, "InsufficientDeliveryPolicyException", "") {
-			return resource.RetryableError(err)
-		}
-
-		return resource.NonRetryableError(err)
-	})
-	if tfresource.TimedOut(err) {
-		_, err = conn.PutDeliveryChannel(&input)
-	}
-	if err != nil {
+	if err := putDeliveryChannel(conn, &input); err != nil {
 		return fmt.Errorf("Creating Delivery Channel failed: %s", err)
 	}
 
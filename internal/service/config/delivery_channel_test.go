@@ -68,6 +68,42 @@ func testAccConfigDeliveryChannel_allParams(t *testing.T) {
 	})
 }
 
+func testAccConfigDeliveryChannel_deliveryFrequency(t *testing.T) {
+	resourceName := "aws_config_delivery_channel.foo"
+	var dc configservice.DeliveryChannel
+	rInt := sdkacctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, configservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigDeliveryChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigDeliveryChannelConfig_deliveryFrequency(rInt, configservice.MaximumExecutionFrequencyTwentyFourHours),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigDeliveryChannelExists(resourceName, &dc),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_delivery_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_delivery_properties.0.delivery_frequency", configservice.MaximumExecutionFrequencyTwentyFourHours),
+				),
+			},
+			{
+				Config: testAccConfigDeliveryChannelConfig_deliveryFrequency(rInt, configservice.MaximumExecutionFrequencyThreeHours),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigDeliveryChannelExists(resourceName, &dc),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_delivery_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_delivery_properties.0.delivery_frequency", configservice.MaximumExecutionFrequencyThreeHours),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccConfigDeliveryChannel_importBasic(t *testing.T) {
 	resourceName := "aws_config_delivery_channel.foo"
 	rInt := sdkacctest.RandInt()
@@ -219,6 +255,74 @@ resource "aws_config_delivery_channel" "foo" {
 `, randInt, randInt, randInt, randInt, randInt)
 }
 
+func testAccConfigDeliveryChannelConfig_deliveryFrequency(randInt int, deliveryFrequency string) string {
+	return fmt.Sprintf(`
+resource "aws_config_configuration_recorder" "foo" {
+  name     = "tf-acc-test-%[1]d"
+  role_arn = aws_iam_role.r.arn
+}
+
+resource "aws_iam_role" "r" {
+  name = "tf-acc-test-awsconfig-%[1]d"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "config.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "p" {
+  name = "tf-acc-test-awsconfig-%[1]d"
+  role = aws_iam_role.r.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": [
+        "s3:*"
+      ],
+      "Effect": "Allow",
+      "Resource": [
+        "${aws_s3_bucket.b.arn}",
+        "${aws_s3_bucket.b.arn}/*"
+      ]
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_s3_bucket" "b" {
+  bucket        = "tf-acc-test-awsconfig-%[1]d"
+  force_destroy = true
+}
+
+resource "aws_config_delivery_channel" "foo" {
+  name           = "tf-acc-test-awsconfig-%[1]d"
+  s3_bucket_name = aws_s3_bucket.b.bucket
+
+  snapshot_delivery_properties {
+    delivery_frequency = %[2]q
+  }
+
+  depends_on = [aws_config_configuration_recorder.foo]
+}
+`, randInt, deliveryFrequency)
+}
+
 func testAccConfigDeliveryChannelConfig_allParams(randInt int) string {
 	return fmt.Sprintf(`
 resource "aws_config_configuration_recorder" "foo" {
@@ -51,9 +51,28 @@ func expandOrganizationAggregationSource(configured map[string]interface{}) *con
 }
 
 func expandRecordingGroup(configured []interface{}) *configservice.RecordingGroup {
+	// An omitted (or empty) recording_group records all supported resource types,
+	// matching the defaults of the block's attributes.
+	if len(configured) == 0 || configured[0] == nil {
+		return &configservice.RecordingGroup{
+			AllSupported:               aws.Bool(true),
+			IncludeGlobalResourceTypes: aws.Bool(false),
+		}
+	}
+
 	recordingGroup := configservice.RecordingGroup{}
 	group := configured[0].(map[string]interface{})
 
+	// Global resource types can only be included alongside all supported resource types,
+	// so recording only global resources means listing them explicitly.
+	if v, ok := group["global_resources_only"].(bool); ok && v {
+		recordingGroup.AllSupported = aws.Bool(false)
+		recordingGroup.IncludeGlobalResourceTypes = aws.Bool(false)
+		recordingGroup.ResourceTypes = aws.StringSlice(globalResourceTypes())
+
+		return &recordingGroup
+	}
+
 	if v, ok := group["all_supported"]; ok {
 		recordingGroup.AllSupported = aws.Bool(v.(bool))
 	}
@@ -160,15 +179,18 @@ func flattenOrganizationAggregationSource(source *configservice.OrganizationAggr
 	return result
 }
 
-func flattenRecordingGroup(g *configservice.RecordingGroup) []map[string]interface{} {
-	m := make(map[string]interface{}, 1)
-
-	if g.AllSupported != nil {
-		m["all_supported"] = *g.AllSupported
+func flattenRecordingGroup(g *configservice.RecordingGroup, globalResourcesOnly bool) []map[string]interface{} {
+	m := map[string]interface{}{
+		"all_supported":                 aws.BoolValue(g.AllSupported),
+		"include_global_resource_types": aws.BoolValue(g.IncludeGlobalResourceTypes),
 	}
 
-	if g.IncludeGlobalResourceTypes != nil {
-		m["include_global_resource_types"] = *g.IncludeGlobalResourceTypes
+	// The global resource types are implied by global_resources_only, so they are only reported
+	// as resource_types if the recorder no longer records exactly those types.
+	if globalResourcesOnly && isGlobalResourcesOnlyRecordingGroup(g) {
+		m["global_resources_only"] = true
+
+		return []map[string]interface{}{m}
 	}
 
 	if g.ResourceTypes != nil && len(g.ResourceTypes) > 0 {
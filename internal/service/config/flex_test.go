@@ -0,0 +1,46 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+)
+
+func TestFlattenRecordingGroup_includeGlobalResourceTypes(t *testing.T) {
+	testCases := []struct {
+		Name                   string
+		Reported               bool
+		ExpectedEffectiveTypes int
+	}{
+		{
+			Name:                   "enabled",
+			Reported:               true,
+			ExpectedEffectiveTypes: len(globalResourceTypes()),
+		},
+		{
+			// Regions that do not record global resource types, e.g. eu-central-2, report false even when true was requested.
+			Name:     "reported disabled",
+			Reported: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			g := &configservice.RecordingGroup{
+				AllSupported:               aws.Bool(true),
+				IncludeGlobalResourceTypes: aws.Bool(testCase.Reported),
+			}
+
+			got := flattenRecordingGroup(g, false)
+
+			if v := got[0]["include_global_resource_types"].(bool); v != testCase.Reported {
+				t.Errorf("got include_global_resource_types %t, expected %t", v, testCase.Reported)
+			}
+
+			if v := effectiveGlobalResourceTypes(g); len(v) != testCase.ExpectedEffectiveTypes {
+				t.Errorf("got %d effective global resource types, expected %d", len(v), testCase.ExpectedEffectiveTypes)
+			}
+		})
+	}
+}
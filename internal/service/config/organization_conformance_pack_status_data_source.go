@@ -0,0 +1,103 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceOrganizationConformancePackStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOrganizationConformancePackStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"member_account_statuses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"organization_conformance_pack_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(configservice.OrganizationResourceDetailedStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceOrganizationConformancePackStatusRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ConfigConn
+
+	name := d.Get("organization_conformance_pack_name").(string)
+	status := d.Get("status").(string)
+
+	statuses, err := configGetOrganizationConformancePackDetailedStatus(conn, name, status)
+
+	if err != nil {
+		return fmt.Errorf("error reading Config Organization Conformance Pack (%s) detailed status: %w", name, err)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return aws.StringValue(statuses[i].AccountId) < aws.StringValue(statuses[j].AccountId)
+	})
+
+	d.SetId(name)
+
+	if err := d.Set("member_account_statuses", flattenOrganizationConformancePackDetailedStatuses(statuses)); err != nil {
+		return fmt.Errorf("error setting member_account_statuses: %w", err)
+	}
+
+	return nil
+}
+
+func flattenOrganizationConformancePackDetailedStatuses(apiObjects []*configservice.OrganizationConformancePackDetailedStatus) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"account_id":    aws.StringValue(apiObject.AccountId),
+			"error_code":    aws.StringValue(apiObject.ErrorCode),
+			"error_message": aws.StringValue(apiObject.ErrorMessage),
+			"status":        aws.StringValue(apiObject.Status),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,75 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccConfigOrganizationConformancePackStatusDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_config_organization_conformance_pack_status.test"
+	resourceName := "aws_config_organization_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, configservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigOrganizationConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigOrganizationConformancePackStatusDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "organization_conformance_pack_name", resourceName, "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "member_account_statuses.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigOrganizationConformancePackStatusDataSource_status(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_config_organization_conformance_pack_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, configservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigOrganizationConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigOrganizationConformancePackStatusDataSourceStatusConfig(rName, configservice.OrganizationResourceDetailedStatusCreateFailed),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "status", configservice.OrganizationResourceDetailedStatusCreateFailed),
+					resource.TestCheckResourceAttr(dataSourceName, "member_account_statuses.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigOrganizationConformancePackStatusDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccConfigOrganizationConformancePackBasicConfig(rName),
+		`
+data "aws_config_organization_conformance_pack_status" "test" {
+  organization_conformance_pack_name = aws_config_organization_conformance_pack.test.name
+}
+`)
+}
+
+func testAccConfigOrganizationConformancePackStatusDataSourceStatusConfig(rName, status string) string {
+	return acctest.ConfigCompose(
+		testAccConfigOrganizationConformancePackBasicConfig(rName),
+		fmt.Sprintf(`
+data "aws_config_organization_conformance_pack_status" "test" {
+  organization_conformance_pack_name = aws_config_organization_conformance_pack.test.name
+  status                             = %[1]q
+}
+`, status))
+}
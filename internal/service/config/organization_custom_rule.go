@@ -15,6 +15,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// organizationCustomRuleLambdaPermissionHint is appended to InsufficientPermissionsException errors,
+// which are returned when the AWS Lambda function's resource-based policy is not yet in place.
+const organizationCustomRuleLambdaPermissionHint = "ensure that an aws_lambda_permission allowing config.amazonaws.com to invoke the AWS Lambda function exists and is listed in depends_on"
+
 func ResourceOrganizationCustomRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceOrganizationCustomRuleCreate,
@@ -170,6 +174,10 @@ func resourceOrganizationCustomRuleCreate(d *schema.ResourceData, meta interface
 
 	_, err := conn.PutOrganizationConfigRule(input)
 
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeInsufficientPermissionsException) {
+		return fmt.Errorf("error creating Config Organization Custom Rule (%s): %s (%s)", name, err, organizationCustomRuleLambdaPermissionHint)
+	}
+
 	if err != nil {
 		return fmt.Errorf("error creating Config Organization Custom Rule (%s): %s", name, err)
 	}
@@ -284,6 +292,10 @@ func resourceOrganizationCustomRuleUpdate(d *schema.ResourceData, meta interface
 
 	_, err := conn.PutOrganizationConfigRule(input)
 
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeInsufficientPermissionsException) {
+		return fmt.Errorf("error updating Config Organization Custom Rule (%s): %s (%s)", d.Id(), err, organizationCustomRuleLambdaPermissionHint)
+	}
+
 	if err != nil {
 		return fmt.Errorf("error updating Config Organization Custom Rule (%s): %s", d.Id(), err)
 	}
@@ -0,0 +1,94 @@
+package config
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	ruleEvaluationStartTimeout = 5 * time.Minute
+)
+
+func ResourceRuleEvaluation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRuleEvaluationCreate,
+		Read:   schema.Noop,
+		Update: resourceRuleEvaluationUpdate,
+		Delete: schema.Noop,
+
+		Schema: map[string]*schema.Schema{
+			"config_rule_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 25,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceRuleEvaluationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ConfigConn
+
+	names := aws.StringValueSlice(flex.ExpandStringSet(d.Get("config_rule_names").(*schema.Set)))
+	sort.Strings(names)
+	id := strings.Join(names, ",")
+
+	if err := startConfigRulesEvaluation(conn, names); err != nil {
+		return fmt.Errorf("error starting Config Rule evaluation (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+func resourceRuleEvaluationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ConfigConn
+
+	if d.HasChange("triggers") {
+		names := aws.StringValueSlice(flex.ExpandStringSet(d.Get("config_rule_names").(*schema.Set)))
+
+		if err := startConfigRulesEvaluation(conn, names); err != nil {
+			return fmt.Errorf("error starting Config Rule evaluation (%s): %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// startConfigRulesEvaluation starts an on-demand evaluation of the specified Config Rules,
+// backing off while the account's limit on concurrent evaluations is reached.
+func startConfigRulesEvaluation(conn *configservice.ConfigService, names []string) error {
+	input := &configservice.StartConfigRulesEvaluationInput{
+		ConfigRuleNames: aws.StringSlice(names),
+	}
+
+	log.Printf("[DEBUG] Starting Config Rule evaluation: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ruleEvaluationStartTimeout, func() (interface{}, error) {
+		return conn.StartConfigRulesEvaluation(input)
+	}, configservice.ErrCodeLimitExceededException)
+
+	return err
+}
@@ -0,0 +1,97 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccConfigRuleEvaluation_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_config_rule_evaluation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, configservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigConfigRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigRuleEvaluationConfig_triggers(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", rName),
+					resource.TestCheckResourceAttr(resourceName, "config_rule_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "config_rule_names.*", "aws_config_config_rule.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.revision", "1"),
+				),
+			},
+			{
+				Config: testAccConfigRuleEvaluationConfig_triggers(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", rName),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.revision", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigRuleEvaluationConfig_triggers(rName, revision string) string {
+	return testAccConfigConfigRuleConfig_basic(rName) + fmt.Sprintf(`
+resource "aws_config_configuration_recorder_status" "test" {
+  name       = aws_config_configuration_recorder.test.name
+  is_enabled = true
+  depends_on = [aws_config_delivery_channel.test]
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": [
+        "s3:*"
+      ],
+      "Effect": "Allow",
+      "Resource": [
+        "${aws_s3_bucket.test.arn}",
+        "${aws_s3_bucket.test.arn}/*"
+      ]
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_config_delivery_channel" "test" {
+  name           = %[1]q
+  s3_bucket_name = aws_s3_bucket.test.bucket
+  depends_on     = [aws_config_configuration_recorder.test, aws_iam_role_policy.test]
+}
+
+resource "aws_config_rule_evaluation" "test" {
+  config_rule_names = [aws_config_config_rule.test.name]
+
+  triggers = {
+    revision = %[2]q
+  }
+
+  depends_on = [aws_config_configuration_recorder_status.test]
+}
+`, rName, revision)
+}
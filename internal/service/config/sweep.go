@@ -6,11 +6,13 @@ package config
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -138,25 +140,46 @@ func sweepConfigurationRecorder(region string) error {
 		return nil
 	}
 
+	var sweeperErrs *multierror.Error
+
 	for _, cr := range resp.ConfigurationRecorders {
+		name := aws.StringValue(cr.Name)
+
+		if !strings.HasPrefix(name, "tf-acc-test") {
+			log.Printf("[INFO] Skipping Config Configuration Recorder: %s", name)
+			continue
+		}
+
+		log.Printf("[INFO] Stopping Config Configuration Recorder: %s", name)
 		_, err := conn.StopConfigurationRecorder(&configservice.StopConfigurationRecorderInput{
-			ConfigurationRecorderName: cr.Name,
+			ConfigurationRecorderName: aws.String(name),
 		})
+		if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConfigurationRecorderException) {
+			continue
+		}
 		if err != nil {
-			return err
+			sweeperErr := fmt.Errorf("error stopping Config Configuration Recorder (%s): %w", name, err)
+			log.Printf("[ERROR] %s", sweeperErr)
+			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+			continue
 		}
 
+		log.Printf("[INFO] Deleting Config Configuration Recorder: %s", name)
 		_, err = conn.DeleteConfigurationRecorder(&configservice.DeleteConfigurationRecorderInput{
-			ConfigurationRecorderName: cr.Name,
+			ConfigurationRecorderName: aws.String(name),
 		})
+		if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConfigurationRecorderException) {
+			continue
+		}
 		if err != nil {
-			return fmt.Errorf(
-				"Error deleting Configuration Recorder (%s): %s",
-				*cr.Name, err)
+			sweeperErr := fmt.Errorf("error deleting Config Configuration Recorder (%s): %w", name, err)
+			log.Printf("[ERROR] %s", sweeperErr)
+			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+			continue
 		}
 	}
 
-	return nil
+	return sweeperErrs.ErrorOrNil()
 }
 
 func sweepDeliveryChannels(region string) error {
@@ -197,6 +220,9 @@ func sweepDeliveryChannels(region string) error {
 		_, err := conn.DeleteDeliveryChannel(&configservice.DeleteDeliveryChannelInput{
 			DeliveryChannelName: dc.Name,
 		})
+		if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchDeliveryChannelException) {
+			continue
+		}
 		if err != nil {
 			return fmt.Errorf(
 				"Error deleting Delivery Channel (%s): %s",
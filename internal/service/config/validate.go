@@ -1,17 +1,142 @@
 package config
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"gopkg.in/yaml.v2"
 )
 
 func validExecutionFrequency() schema.SchemaValidateFunc {
-	return validation.StringInSlice([]string{
-		configservice.MaximumExecutionFrequencyOneHour,
-		configservice.MaximumExecutionFrequencyThreeHours,
-		configservice.MaximumExecutionFrequencySixHours,
-		configservice.MaximumExecutionFrequencyTwelveHours,
-		configservice.MaximumExecutionFrequencyTwentyFourHours,
-	}, false)
+	return validation.StringInSlice(configservice.MaximumExecutionFrequency_Values(), false)
+}
+
+// validRoleARN validates that the specified value is an IAM role ARN, e.g. arn:aws:iam::123456789012:role/config-role.
+// IAM user, group and policy ARNs, as well as the ARNs of resources in other services, are rejected.
+func validRoleARN(v interface{}, k string) (ws []string, errors []error) {
+	ws, errors = verify.ValidARN(v, k)
+
+	if len(errors) > 0 {
+		return
+	}
+
+	value := v.(string)
+
+	if value == "" {
+		return
+	}
+
+	parsedARN, err := arn.Parse(value)
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %w", k, value, err))
+		return
+	}
+
+	if parsedARN.Service != "iam" || parsedARN.Region != "" || parsedARN.AccountID == "" || !strings.HasPrefix(parsedARN.Resource, "role/") || len(parsedARN.Resource) == len("role/") {
+		errors = append(errors, fmt.Errorf("%q (%s) must be an IAM role ARN, e.g. arn:%s:iam::123456789012:role/example", k, value, parsedARN.Partition))
+	}
+
+	return
+}
+
+// validRuleScope returns an error if the specified Config Rule scope combination is rejected by AWS.
+// A resource ID requires exactly one resource type, and a tag value requires a tag key.
+func validRuleScope(resourceID string, resourceTypes []string, tagKey, tagValue string) error {
+	if tagValue != "" && tagKey == "" {
+		return fmt.Errorf("scope: tag_key must be set when tag_value is set")
+	}
+
+	if resourceID != "" && len(resourceTypes) != 1 {
+		return fmt.Errorf("scope: compliance_resource_id requires exactly one compliance_resource_types entry, got %d", len(resourceTypes))
+	}
+
+	return nil
+}
+
+// validConformancePackTemplateBody performs best-effort checks of the structure of a conformance pack template
+// so that obvious errors are reported at plan time rather than after the asynchronous deployment fails.
+// Templates that cannot be parsed are left to verify.ValidStringIsJSONOrYAML.
+func validConformancePackTemplateBody(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok || value == "" {
+		return
+	}
+
+	var template interface{}
+
+	if err := json.Unmarshal([]byte(value), &template); err != nil {
+		if err := yaml.Unmarshal([]byte(value), &template); err != nil {
+			return
+		}
+	}
+
+	sections, ok := templateObject(template)
+	if !ok {
+		errors = append(errors, fmt.Errorf("%q must be a template object with a Resources section", k))
+		return
+	}
+
+	if v, ok := sections["Parameters"]; ok {
+		parameters, ok := templateObject(v)
+		if !ok {
+			errors = append(errors, fmt.Errorf("%q: Parameters section must be an object", k))
+		}
+
+		for name, v := range parameters {
+			if _, ok := templateObject(v); !ok {
+				errors = append(errors, fmt.Errorf("%q: parameter %q must be an object", k, name))
+			}
+		}
+	}
+
+	v, ok = sections["Resources"]
+	if !ok {
+		errors = append(errors, fmt.Errorf("%q must contain a Resources section", k))
+		return
+	}
+
+	resources, ok := templateObject(v)
+	if !ok || len(resources) == 0 {
+		errors = append(errors, fmt.Errorf("%q: Resources section must be an object with at least one resource", k))
+		return
+	}
+
+	for name, v := range resources {
+		resource, ok := templateObject(v)
+		if !ok {
+			errors = append(errors, fmt.Errorf("%q: resource %q must be an object", k, name))
+			continue
+		}
+
+		if resourceType, ok := resource["Type"].(string); !ok || resourceType == "" {
+			errors = append(errors, fmt.Errorf("%q: resource %q must have a Type", k, name))
+		}
+	}
+
+	return
+}
+
+// templateObject returns the specified decoded JSON or YAML template value as an object.
+func templateObject(v interface{}) (map[string]interface{}, bool) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		return v, true
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+
+		for k, v := range v {
+			m[fmt.Sprint(k)] = v
+		}
+
+		return m, true
+	default:
+		return nil, false
+	}
 }
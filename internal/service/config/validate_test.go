@@ -0,0 +1,240 @@
+package config
+
+import (
+	"testing"
+)
+
+func TestValidRoleARN(t *testing.T) {
+	validARNs := []string{
+		"arn:aws:iam::123456789012:role/config-role",                                                   //lintignore:AWSAT005
+		"arn:aws:iam::123456789012:role/service-role/config-role",                                      //lintignore:AWSAT005
+		"arn:aws:iam::123456789012:role/aws-service-role/config.amazonaws.com/AWSServiceRoleForConfig", //lintignore:AWSAT005
+		"arn:aws-us-gov:iam::123456789012:role/config-role",                                            //lintignore:AWSAT005
+		"arn:aws-cn:iam::123456789012:role/config-role",                                                //lintignore:AWSAT005
+	}
+	for _, v := range validARNs {
+		_, errors := validRoleARN(v, "role_arn")
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid IAM role ARN: %q", v, errors)
+		}
+	}
+
+	invalidARNs := []string{
+		"config-role",
+		"arn:aws:iam::123456789012:user/config-user",                 //lintignore:AWSAT005
+		"arn:aws:iam::123456789012:group/config-group",               //lintignore:AWSAT005
+		"arn:aws:iam::123456789012:policy/config-policy",             //lintignore:AWSAT005
+		"arn:aws:iam::123456789012:instance-profile/config",          //lintignore:AWSAT005
+		"arn:aws:iam::aws:policy/service-role/AWS_ConfigRole",        //lintignore:AWSAT005
+		"arn:aws:iam::123456789012:role/",                            //lintignore:AWSAT005
+		"arn:aws:iam:us-west-2:123456789012:role/config-role",        //lintignore:AWSAT003,AWSAT005
+		"arn:aws:sts::123456789012:assumed-role/config-role/session", //lintignore:AWSAT005
+		"arn:aws:s3:::config-bucket",                                 //lintignore:AWSAT005
+		"arn:aws:sns:us-west-2:123456789012:config-topic",            //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range invalidARNs {
+		_, errors := validRoleARN(v, "role_arn")
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid IAM role ARN", v)
+		}
+	}
+}
+
+func TestValidRuleScope(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceID    string
+		ResourceTypes []string
+		TagKey        string
+		TagValue      string
+		ExpectedError bool
+	}{
+		{
+			Name: "empty",
+		},
+		{
+			Name:          "resource types",
+			ResourceTypes: []string{"AWS::EC2::Instance", "AWS::EC2::Volume"},
+		},
+		{
+			Name:          "resource type and resource ID",
+			ResourceID:    "i-1234567890abcdef0",
+			ResourceTypes: []string{"AWS::EC2::Instance"},
+		},
+		{
+			Name:   "tag key",
+			TagKey: "Environment",
+		},
+		{
+			Name:     "tag key and value",
+			TagKey:   "Environment",
+			TagValue: "production",
+		},
+		{
+			Name:          "tag key and resource types",
+			ResourceTypes: []string{"AWS::EC2::Instance"},
+			TagKey:        "Environment",
+		},
+		{
+			Name:          "resource ID without resource type",
+			ResourceID:    "i-1234567890abcdef0",
+			ExpectedError: true,
+		},
+		{
+			Name:          "resource ID with multiple resource types",
+			ResourceID:    "i-1234567890abcdef0",
+			ResourceTypes: []string{"AWS::EC2::Instance", "AWS::EC2::Volume"},
+			ExpectedError: true,
+		},
+		{
+			Name:          "tag value without tag key",
+			TagValue:      "production",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := validRuleScope(testCase.ResourceID, testCase.ResourceTypes, testCase.TagKey, testCase.TagValue)
+
+			if testCase.ExpectedError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidConformancePackTemplateBody(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		TemplateBody  string
+		ExpectedError bool
+	}{
+		{
+			Name: "YAML",
+			TemplateBody: `
+Parameters:
+  AccessKeysRotatedParameterMaxAccessKeyAge:
+    Type: String
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+`,
+		},
+		{
+			Name: "YAML with intrinsic function",
+			TemplateBody: `
+Parameters:
+  RuleName:
+    Type: String
+Resources:
+  Rule:
+    Properties:
+      ConfigRuleName: !Ref RuleName
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+`,
+		},
+		{
+			Name:         "JSON",
+			TemplateBody: "{\n\t\"Resources\": {\n\t\t\"IAMPasswordPolicy\": {\n\t\t\t\"Type\": \"AWS::Config::ConfigRule\",\n\t\t\t\"Properties\": {\"ConfigRuleName\": \"IAMPasswordPolicy\"}\n\t\t}\n\t}\n}",
+		},
+		{
+			Name:         "unparseable",
+			TemplateBody: `{"Resources": {`,
+		},
+		{
+			Name:          "scalar",
+			TemplateBody:  `Resources`,
+			ExpectedError: true,
+		},
+		{
+			Name:          "list",
+			TemplateBody:  `[{"Resources": {}}]`,
+			ExpectedError: true,
+		},
+		{
+			Name: "no Resources",
+			TemplateBody: `
+Parameters:
+  RuleName:
+    Type: String
+`,
+			ExpectedError: true,
+		},
+		{
+			Name:          "empty Resources",
+			TemplateBody:  `{"Resources": {}}`,
+			ExpectedError: true,
+		},
+		{
+			Name:          "Resources list",
+			TemplateBody:  `{"Resources": [{"Type": "AWS::Config::ConfigRule"}]}`,
+			ExpectedError: true,
+		},
+		{
+			Name: "resource without Type",
+			TemplateBody: `
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+`,
+			ExpectedError: true,
+		},
+		{
+			Name: "resource not an object",
+			TemplateBody: `
+Resources:
+  IAMPasswordPolicy: AWS::Config::ConfigRule
+`,
+			ExpectedError: true,
+		},
+		{
+			Name: "Parameters not an object",
+			TemplateBody: `
+Parameters: RuleName
+Resources:
+  IAMPasswordPolicy:
+    Type: AWS::Config::ConfigRule
+`,
+			ExpectedError: true,
+		},
+		{
+			Name: "parameter not an object",
+			TemplateBody: `
+Parameters:
+  RuleName: String
+Resources:
+  IAMPasswordPolicy:
+    Type: AWS::Config::ConfigRule
+`,
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, errs := validConformancePackTemplateBody(testCase.TemplateBody, "template_body")
+
+			if testCase.ExpectedError && len(errs) == 0 {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && len(errs) > 0 {
+				t.Fatalf("unexpected errors: %v", errs)
+			}
+		})
+	}
+}
@@ -38,6 +38,63 @@ type IAMPolicyStatementCondition struct {
 type IAMPolicyStatementPrincipalSet []IAMPolicyStatementPrincipal
 type IAMPolicyStatementConditionSet []IAMPolicyStatementCondition
 
+// ActionList returns the statement's Action element as a list of actions.
+func (s *IAMPolicyStatement) ActionList() ([]string, error) {
+	switch v := s.Actions.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case []string:
+		return v, nil
+	case []interface{}:
+		actions := make([]string, 0, len(v))
+
+		for _, action := range v {
+			s, ok := action.(string)
+
+			if !ok {
+				return nil, fmt.Errorf("Unsupported data type %T for IAMPolicyStatement.Actions", action)
+			}
+
+			actions = append(actions, s)
+		}
+
+		return actions, nil
+	default:
+		return nil, fmt.Errorf("Unsupported data type %T for IAMPolicyStatement.Actions", v)
+	}
+}
+
+// IdentifierList returns the principal's identifiers as a list.
+func (p IAMPolicyStatementPrincipal) IdentifierList() []string {
+	switch v := p.Identifiers.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	default:
+		return nil
+	}
+}
+
+// HasAnonymous returns whether the set includes the anonymous principal ("*" or {"AWS": "*"}).
+func (ps IAMPolicyStatementPrincipalSet) HasAnonymous() bool {
+	for _, principal := range ps {
+		if principal.Type != "*" && principal.Type != "AWS" {
+			continue
+		}
+
+		for _, identifier := range principal.IdentifierList() {
+			if identifier == "*" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (s *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
 	// adopt newDoc's Id
 	if len(newDoc.Id) > 0 {
@@ -0,0 +1,112 @@
+package iam
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestIAMPolicyStatementActionList(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Statement     string
+		Expected      []string
+		ExpectedError bool
+	}{
+		{
+			Name:      "no action",
+			Statement: `{"Effect":"Allow","NotAction":"ses:SendEmail","Resource":"*"}`,
+		},
+		{
+			Name:      "single action",
+			Statement: `{"Effect":"Allow","Action":"ses:SendEmail","Resource":"*"}`,
+			Expected:  []string{"ses:SendEmail"},
+		},
+		{
+			Name:      "action list",
+			Statement: `{"Effect":"Allow","Action":["ses:SendEmail","ses:SendRawEmail"],"Resource":"*"}`,
+			Expected:  []string{"ses:SendEmail", "ses:SendRawEmail"},
+		},
+		{
+			Name:          "invalid action",
+			Statement:     `{"Effect":"Allow","Action":["ses:SendEmail",1],"Resource":"*"}`,
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var statement IAMPolicyStatement
+
+			if err := json.Unmarshal([]byte(testCase.Statement), &statement); err != nil {
+				t.Fatalf("error unmarshalling statement: %s", err)
+			}
+
+			got, err := statement.ActionList()
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyStatementPrincipalSetHasAnonymous(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Principal string
+		Expected  bool
+	}{
+		{
+			Name:      "wildcard",
+			Principal: `"*"`,
+			Expected:  true,
+		},
+		{
+			Name:      "AWS wildcard",
+			Principal: `{"AWS":"*"}`,
+			Expected:  true,
+		},
+		{
+			Name:      "AWS wildcard in list",
+			Principal: `{"AWS":["arn:aws:iam::123456789012:root","*"]}`, //lintignore:AWSAT005
+			Expected:  true,
+		},
+		{
+			Name:      "account",
+			Principal: `{"AWS":"arn:aws:iam::123456789012:root"}`, //lintignore:AWSAT005
+			Expected:  false,
+		},
+		{
+			Name:      "service",
+			Principal: `{"Service":"ses.amazonaws.com"}`,
+			Expected:  false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var principals IAMPolicyStatementPrincipalSet
+
+			if err := json.Unmarshal([]byte(testCase.Principal), &principals); err != nil {
+				t.Fatalf("error unmarshalling principal: %s", err)
+			}
+
+			if got := principals.HasAnonymous(); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
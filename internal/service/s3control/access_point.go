@@ -1,9 +1,11 @@
 package s3control
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -12,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -42,7 +45,20 @@ func ResourceAccessPoint() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.NoZeroValues,
+				ValidateFunc: validAccessPointBucket,
+				StateFunc: func(v interface{}) string {
+					bucket, err := AccessPointBucket(v.(string))
+
+					if err != nil {
+						return v.(string)
+					}
+
+					return bucket
+				},
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"domain_name": {
 				Type:     schema.TypeString,
@@ -59,13 +75,18 @@ func ResourceAccessPoint() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 			"network_origin": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(s3control.NetworkOrigin_Values(), true),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
 			},
 			"policy": {
 				Type:             schema.TypeString,
 				Optional:         true,
-				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressFunc: suppressEquivalentAccessPointPolicyDiffs,
 			},
 			"public_access_block_configuration": {
 				Type:             schema.TypeList,
@@ -119,6 +140,8 @@ func ResourceAccessPoint() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: resourceAccessPointCustomizeDiff,
 	}
 }
 
@@ -131,9 +154,14 @@ func resourceAccessPointCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	name := d.Get("name").(string)
 
+	bucket, err := AccessPointBucket(d.Get("bucket").(string))
+	if err != nil {
+		return err
+	}
+
 	input := &s3control.CreateAccessPointInput{
 		AccountId:                      aws.String(accountId),
-		Bucket:                         aws.String(d.Get("bucket").(string)),
+		Bucket:                         aws.String(bucket),
 		Name:                           aws.String(name),
 		PublicAccessBlockConfiguration: expandS3AccessPointPublicAccessBlockConfiguration(d.Get("public_access_block_configuration").([]interface{})),
 		VpcConfiguration:               expandS3AccessPointVpcConfiguration(d.Get("vpc_configuration").([]interface{})),
@@ -142,6 +170,18 @@ func resourceAccessPointCreate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Creating S3 Access Point: %s", input)
 	output, err := conn.CreateAccessPoint(input)
 
+	// A previous apply may have created the Access Point without recording it in state.
+	// It may also be managed by another configuration, so it is not adopted.
+	if tfawserr.ErrCodeEquals(err, errCodeAccessPointAlreadyOwnedByYou) {
+		return fmt.Errorf("error creating S3 Control Access Point (%s): an access point with this name already exists in account %s, use \"terraform import\" with ID %s:%s to manage it: %w", name, accountId, accountId, name, err)
+	}
+
+	if v := input.VpcConfiguration; v != nil {
+		if err := accessPointInvalidVPCError(err, aws.StringValue(v.VpcId)); err != nil {
+			return fmt.Errorf("error creating S3 Control Access Point (%s): %w", name, err)
+		}
+	}
+
 	if err != nil {
 		return fmt.Errorf("error creating S3 Control Access Point (%s): %w", name, err)
 	}
@@ -183,12 +223,11 @@ func resourceAccessPointRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	output, err := conn.GetAccessPoint(&s3control.GetAccessPointInput{
-		AccountId: aws.String(accountId),
-		Name:      aws.String(name),
-	})
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(accessPointCreatedPropagationTimeout, func() (interface{}, error) {
+		return FindAccessPointByAccountIDAndName(conn, accountId, name)
+	}, d.IsNewResource())
 
-	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchAccessPoint) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] S3 Access Point (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -198,91 +237,22 @@ func resourceAccessPointRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading S3 Access Point (%s): %w", d.Id(), err)
 	}
 
-	if output == nil {
-		return fmt.Errorf("error reading S3 Access Point (%s): empty response", d.Id())
-	}
+	output := outputRaw.(*s3control.GetAccessPointOutput)
 
-	if strings.HasPrefix(name, "arn:") {
-		parsedAccessPointARN, err := arn.Parse(name)
+	// A newly created Access Point can momentarily be returned without its public access block configuration.
+	// S3 on Outposts Access Points always block public access and are not waited on.
+	if d.IsNewResource() && output.PublicAccessBlockConfiguration == nil && !strings.HasPrefix(name, "arn:") {
+		v, err := waitAccessPointPublicAccessBlockConfigurationAvailable(conn, accountId, name)
 
 		if err != nil {
-			return fmt.Errorf("error parsing S3 Control Access Point ARN (%s): %w", name, err)
-		}
-
-		bucketARN := arn.ARN{
-			AccountID: parsedAccessPointARN.AccountID,
-			Partition: parsedAccessPointARN.Partition,
-			Region:    parsedAccessPointARN.Region,
-			Resource: strings.Replace(
-				parsedAccessPointARN.Resource,
-				fmt.Sprintf("accesspoint/%s", aws.StringValue(output.Name)),
-				fmt.Sprintf("bucket/%s", aws.StringValue(output.Bucket)),
-				1,
-			),
-			Service: parsedAccessPointARN.Service,
-		}
-
-		d.Set("arn", name)
-		d.Set("bucket", bucketARN.String())
-	} else {
-		accessPointARN := arn.ARN{
-			AccountID: accountId,
-			Partition: meta.(*conns.AWSClient).Partition,
-			Region:    meta.(*conns.AWSClient).Region,
-			Resource:  fmt.Sprintf("accesspoint/%s", aws.StringValue(output.Name)),
-			Service:   "s3",
+			return fmt.Errorf("error waiting for S3 Access Point (%s) public access block configuration: %w", d.Id(), err)
 		}
 
-		d.Set("arn", accessPointARN.String())
-		d.Set("bucket", output.Bucket)
-	}
-
-	d.Set("account_id", accountId)
-	d.Set("domain_name", meta.(*conns.AWSClient).RegionalHostname(fmt.Sprintf("%s-%s.s3-accesspoint", aws.StringValue(output.Name), accountId)))
-	d.Set("name", output.Name)
-	d.Set("network_origin", output.NetworkOrigin)
-	if err := d.Set("public_access_block_configuration", flattenS3AccessPointPublicAccessBlockConfiguration(output.PublicAccessBlockConfiguration)); err != nil {
-		return fmt.Errorf("error setting public_access_block_configuration: %s", err)
-	}
-	if err := d.Set("vpc_configuration", flattenS3AccessPointVpcConfiguration(output.VpcConfiguration)); err != nil {
-		return fmt.Errorf("error setting vpc_configuration: %s", err)
+		output = v
 	}
 
-	policyOutput, err := conn.GetAccessPointPolicy(&s3control.GetAccessPointPolicyInput{
-		AccountId: aws.String(accountId),
-		Name:      aws.String(name),
-	})
-
-	if tfawserr.ErrMessageContains(err, "NoSuchAccessPointPolicy", "") {
-		d.Set("policy", "")
-	} else {
-		if err != nil {
-			return fmt.Errorf("error reading S3 Access Point (%s) policy: %s", d.Id(), err)
-		}
-
-		d.Set("policy", policyOutput.Policy)
-	}
-
-	// Return early since S3 on Outposts cannot have public policies
-	if strings.HasPrefix(name, "arn:") {
-		d.Set("has_public_access_policy", false)
-
-		return nil
-	}
-
-	policyStatusOutput, err := conn.GetAccessPointPolicyStatus(&s3control.GetAccessPointPolicyStatusInput{
-		AccountId: aws.String(accountId),
-		Name:      aws.String(name),
-	})
-
-	if tfawserr.ErrMessageContains(err, "NoSuchAccessPointPolicy", "") {
-		d.Set("has_public_access_policy", false)
-	} else {
-		if err != nil {
-			return fmt.Errorf("error reading S3 Access Point (%s) policy status: %s", d.Id(), err)
-		}
-
-		d.Set("has_public_access_policy", policyStatusOutput.PolicyStatus.IsPublic)
+	if err := accessPointDescriptionAttributes(d, meta.(*conns.AWSClient), accountId, name, output); err != nil {
+		return err
 	}
 
 	return nil
@@ -315,7 +285,7 @@ func resourceAccessPointUpdate(d *schema.ResourceData, meta interface{}) error {
 				Name:      aws.String(name),
 			})
 
-			if err != nil {
+			if err != nil && !tfawserr.ErrMessageContains(err, "NoSuchAccessPointPolicy", "") {
 				return fmt.Errorf("error deleting S3 Access Point (%s) policy: %s", d.Id(), err)
 			}
 		}
@@ -349,6 +319,105 @@ func resourceAccessPointDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// accessPointDescriptionAttributes sets the attributes shared by the aws_s3_access_point resource and data source
+// from the Access Point and its policy. name is the Access Point name (S3) or ARN (S3 on Outposts).
+func accessPointDescriptionAttributes(d *schema.ResourceData, client *conns.AWSClient, accountID, name string, output *s3control.GetAccessPointOutput) error {
+	conn := client.S3ControlConn
+	outposts := strings.HasPrefix(name, "arn:")
+
+	if outposts {
+		parsedAccessPointARN, err := arn.Parse(name)
+
+		if err != nil {
+			return fmt.Errorf("error parsing S3 Control Access Point ARN (%s): %w", name, err)
+		}
+
+		bucketARN, err := accessPointOutpostsBucketARN(parsedAccessPointARN, aws.StringValue(output.Bucket))
+
+		if err != nil {
+			return fmt.Errorf("error reading S3 Control Access Point (%s) bucket: %w", name, err)
+		}
+
+		d.Set("arn", name)
+		d.Set("bucket", bucketARN)
+	} else {
+		accessPointARN := aws.StringValue(output.AccessPointArn)
+
+		if accessPointARN == "" {
+			accessPointARN = arn.ARN{
+				AccountID: accountID,
+				Partition: client.Partition,
+				Region:    client.Region,
+				Resource:  fmt.Sprintf("accesspoint/%s", aws.StringValue(output.Name)),
+				Service:   "s3",
+			}.String()
+		}
+
+		d.Set("arn", accessPointARN)
+		d.Set("bucket", output.Bucket)
+	}
+
+	d.Set("account_id", accountID)
+	if output.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(output.CreationDate).Format(time.RFC3339))
+	}
+	d.Set("domain_name", AccessPointDomainName(client, aws.StringValue(output.Name), accountID))
+	d.Set("name", output.Name)
+	d.Set("network_origin", output.NetworkOrigin)
+	if err := d.Set("public_access_block_configuration", flattenS3AccessPointPublicAccessBlockConfiguration(output.PublicAccessBlockConfiguration)); err != nil {
+		return fmt.Errorf("error setting public_access_block_configuration: %w", err)
+	}
+	if err := d.Set("vpc_configuration", flattenS3AccessPointVpcConfiguration(output.VpcConfiguration)); err != nil {
+		return fmt.Errorf("error setting vpc_configuration: %w", err)
+	}
+
+	policyOutput, err := conn.GetAccessPointPolicy(&s3control.GetAccessPointPolicyInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchAccessPointPolicy) {
+		d.Set("policy", "")
+	} else {
+		if err != nil {
+			return fmt.Errorf("error reading S3 Access Point (%s) policy: %w", name, err)
+		}
+
+		if policy := aws.StringValue(policyOutput.Policy); accessPointPolicyIsEmpty(policy) {
+			d.Set("policy", "")
+		} else {
+			d.Set("policy", policy)
+		}
+	}
+
+	// Return early since S3 on Outposts cannot have public policies
+	if outposts {
+		d.Set("has_public_access_policy", false)
+
+		return nil
+	}
+
+	policyStatus, err := FindAccessPointPolicyStatusByAccountIDAndName(conn, accountID, name)
+
+	if tfresource.NotFound(err) {
+		d.Set("has_public_access_policy", false)
+	} else {
+		if err != nil {
+			return fmt.Errorf("error reading S3 Access Point (%s) policy status: %w", name, err)
+		}
+
+		d.Set("has_public_access_policy", policyStatus.IsPublic)
+	}
+
+	return nil
+}
+
+// AccessPointDomainName returns the DNS domain name of the specified S3 Access Point,
+// e.g. NAME-ACCOUNT_ID.s3-accesspoint.us-gov-west-1.amazonaws.com or NAME-ACCOUNT_ID.s3-accesspoint.cn-north-1.amazonaws.com.cn.
+func AccessPointDomainName(client *conns.AWSClient, name, accountID string) string {
+	return client.RegionalHostname(fmt.Sprintf("%s-%s.s3-accesspoint", name, accountID))
+}
+
 // AccessPointParseID returns the Account ID and Access Point Name (S3) or ARN (S3 on Outposts)
 func AccessPointParseID(id string) (string, string, error) {
 	parsedARN, err := arn.Parse(id)
@@ -366,6 +435,96 @@ func AccessPointParseID(id string) (string, string, error) {
 	return parts[0], parts[1], nil
 }
 
+// AccessPointBucket parses the bucket argument of an S3 Access Point, which is either
+// a bucket name, an S3 bucket ARN or an S3 on Outposts bucket ARN, and returns its normalized form:
+// the bucket name for S3 buckets and the bucket ARN for S3 on Outposts buckets.
+func AccessPointBucket(v string) (string, error) {
+	if !arn.IsARN(v) {
+		if v == "" {
+			return "", fmt.Errorf("bucket must not be empty")
+		}
+
+		return v, nil
+	}
+
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing bucket ARN (%s): %w", v, err)
+	}
+
+	switch parsedARN.Service {
+	case "s3":
+		if parsedARN.Resource == "" || strings.Contains(parsedARN.Resource, "/") {
+			return "", fmt.Errorf("unexpected format of S3 bucket ARN (%s), expected arn:PARTITION:s3:::BUCKET", v)
+		}
+
+		return parsedARN.Resource, nil
+	case "s3-outposts":
+		parts := strings.Split(parsedARN.Resource, "/")
+
+		if len(parts) != 4 || parts[0] != "outpost" || parts[1] == "" || parts[2] != "bucket" || parts[3] == "" {
+			return "", fmt.Errorf("unexpected format of S3 on Outposts bucket ARN (%s), expected arn:PARTITION:s3-outposts:REGION:ACCOUNT_ID:outpost/OUTPOST_ID/bucket/BUCKET", v)
+		}
+
+		return v, nil
+	}
+
+	return "", fmt.Errorf("unexpected service in bucket ARN (%s), expected s3 or s3-outposts", v)
+}
+
+func validAccessPointBucket(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := AccessPointBucket(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
+
+// validAccessPointOutpostsAccountID returns an error if the bucket is an S3 on Outposts bucket ARN
+// in an account other than the one specified.
+func validAccessPointOutpostsAccountID(accountID, bucket string) error {
+	if !arn.IsARN(bucket) {
+		return nil
+	}
+
+	parsedARN, err := arn.Parse(bucket)
+
+	if err != nil || parsedARN.Service != "s3-outposts" {
+		return nil
+	}
+
+	if parsedARN.AccountID != accountID {
+		return fmt.Errorf("account_id (%s) does not match the account ID (%s) of the S3 on Outposts bucket (%s)", accountID, parsedARN.AccountID, bucket)
+	}
+
+	return nil
+}
+
+// accessPointOutpostsBucketARN returns the ARN of the bucket of an S3 on Outposts Access Point.
+// The bucket is returned by the API either as a name or as an ARN.
+func accessPointOutpostsBucketARN(accessPointARN arn.ARN, bucket string) (string, error) {
+	if arn.IsARN(bucket) {
+		return AccessPointBucket(bucket)
+	}
+
+	parts := strings.Split(accessPointARN.Resource, "/")
+
+	if len(parts) != 4 || parts[0] != "outpost" || parts[2] != "accesspoint" {
+		return "", fmt.Errorf("unexpected format of S3 on Outposts Access Point ARN (%s)", accessPointARN)
+	}
+
+	bucketARN := arn.ARN{
+		AccountID: accessPointARN.AccountID,
+		Partition: accessPointARN.Partition,
+		Region:    accessPointARN.Region,
+		Resource:  fmt.Sprintf("outpost/%s/bucket/%s", parts[1], bucket),
+		Service:   accessPointARN.Service,
+	}
+
+	return bucketARN.String(), nil
+}
+
 func expandS3AccessPointVpcConfiguration(vConfig []interface{}) *s3control.VpcConfiguration {
 	if len(vConfig) == 0 || vConfig[0] == nil {
 		return nil
@@ -415,3 +574,43 @@ func flattenS3AccessPointPublicAccessBlockConfiguration(config *s3control.Public
 		"restrict_public_buckets": aws.BoolValue(config.RestrictPublicBuckets),
 	}}
 }
+
+func resourceAccessPointCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// S3 on Outposts Access Points are created in the account of the Outposts bucket.
+	if diff.NewValueKnown("account_id") && diff.NewValueKnown("bucket") {
+		if accountID := diff.Get("account_id").(string); accountID != "" {
+			if err := validAccessPointOutpostsAccountID(accountID, diff.Get("bucket").(string)); err != nil {
+				return err
+			}
+		}
+	}
+
+	// The network origin is fixed at creation by the presence of vpc_configuration.
+	// Changing vpc_configuration forces replacement, for which the diff is customized again without prior state.
+	if diff.Id() != "" && diff.HasChange("vpc_configuration") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("vpc_configuration") {
+		return nil
+	}
+
+	networkOrigin := NetworkOriginInternet
+	if v, ok := diff.Get("vpc_configuration").([]interface{}); ok && len(v) > 0 {
+		networkOrigin = NetworkOriginVPC
+	}
+
+	if v := diff.Get("network_origin").(string); v != "" {
+		if !strings.EqualFold(v, networkOrigin) {
+			if networkOrigin == NetworkOriginVPC {
+				return fmt.Errorf("network_origin (%s) cannot be used with vpc_configuration", v)
+			}
+
+			return fmt.Errorf("network_origin (%s) requires vpc_configuration", v)
+		}
+
+		return nil
+	}
+
+	return diff.SetNew("network_origin", networkOrigin)
+}
@@ -0,0 +1,203 @@
+package s3control
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceAccessPoint() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAccessPointRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  verify.ValidAccountID,
+				ConflictsWith: []string{"arn"},
+			},
+			"alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"arn", "name"},
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"has_public_access_policy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"arn", "name"},
+			},
+			"network_origin": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_access_block_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"block_public_acls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"block_public_policy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ignore_public_acls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"restrict_public_buckets": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"vpc_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAccessPointRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*conns.AWSClient)
+	conn := client.S3ControlConn
+
+	var accountID, name string
+	var outposts bool
+
+	if v, ok := d.GetOk("arn"); ok {
+		var err error
+		accountID, name, err = AccessPointAccountIDAndNameFromARN(v.(string))
+
+		if err != nil {
+			return err
+		}
+
+		outposts = arn.IsARN(name)
+
+		// Standard access points are addressed by name, so must be in the provider's Region.
+		if parsedARN, _ := arn.Parse(v.(string)); !outposts && parsedARN.Region != client.Region {
+			return fmt.Errorf("S3 Access Point ARN (%s) is not in the provider Region (%s)", v.(string), client.Region)
+		}
+	} else {
+		name = d.Get("name").(string)
+
+		if v, ok := d.GetOk("account_id"); ok {
+			accountID = v.(string)
+		} else {
+			accountID = client.AccountID
+		}
+	}
+
+	output, err := FindAccessPointByAccountIDAndName(conn, accountID, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Access Point (%s): %w", name, err)
+	}
+
+	if outposts {
+		d.SetId(name)
+	} else {
+		d.SetId(fmt.Sprintf("%s:%s", accountID, aws.StringValue(output.Name)))
+	}
+
+	d.Set("alias", output.Alias)
+
+	if err := accessPointDescriptionAttributes(d, client, accountID, name, output); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AccessPointAccountIDAndNameFromARN returns the account ID and the name used to address an S3 Access Point
+// in the S3 Control API from the Access Point's ARN.
+// Standard Access Points are addressed by name and S3 on Outposts Access Points by ARN.
+func AccessPointAccountIDAndNameFromARN(v string) (string, string, error) {
+	if !arn.IsARN(v) {
+		return "", "", fmt.Errorf("unexpected format of S3 Access Point ARN (%s)", v)
+	}
+
+	accountID, name, err := AccessPointParseID(v)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	parsedARN, err := arn.Parse(name)
+
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing S3 Access Point ARN (%s): %w", v, err)
+	}
+
+	if accountID == "" {
+		return "", "", fmt.Errorf("unexpected format of S3 Access Point ARN (%s), missing account ID", v)
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	switch parsedARN.Service {
+	case "s3":
+		if len(parts) != 2 || parts[0] != "accesspoint" || parts[1] == "" {
+			return "", "", fmt.Errorf("unexpected format of S3 Access Point ARN (%s), expected arn:PARTITION:s3:REGION:ACCOUNT_ID:accesspoint/NAME", v)
+		}
+
+		return accountID, parts[1], nil
+	case "s3-outposts":
+		if len(parts) != 4 || parts[0] != "outpost" || parts[1] == "" || parts[2] != "accesspoint" || parts[3] == "" {
+			return "", "", fmt.Errorf("unexpected format of S3 on Outposts Access Point ARN (%s), expected arn:PARTITION:s3-outposts:REGION:ACCOUNT_ID:outpost/OUTPOST_ID/accesspoint/NAME", v)
+		}
+
+		return accountID, v, nil
+	default:
+		return "", "", fmt.Errorf("unexpected service (%s) in S3 Access Point ARN (%s)", parsedARN.Service, v)
+	}
+}
@@ -0,0 +1,249 @@
+package s3control_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+)
+
+func TestAccessPointAccountIDAndNameFromARN(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		Input             string
+		ExpectedAccountID string
+		ExpectedName      string
+		ExpectedError     bool
+	}{
+		{
+			Name:              "S3 ARN",
+			Input:             "arn:aws:s3:us-west-2:123456789012:accesspoint/example", //lintignore:AWSAT003,AWSAT005
+			ExpectedAccountID: "123456789012",
+			ExpectedName:      "example",
+		},
+		{
+			Name:              "S3 ARN GovCloud",
+			Input:             "arn:aws-us-gov:s3:us-gov-west-1:123456789012:accesspoint/example", //lintignore:AWSAT003,AWSAT005
+			ExpectedAccountID: "123456789012",
+			ExpectedName:      "example",
+		},
+		{
+			Name:              "S3 on Outposts ARN",
+			Input:             "arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/accesspoint/example", //lintignore:AWSAT003,AWSAT005
+			ExpectedAccountID: "123456789012",
+			ExpectedName:      "arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/accesspoint/example", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name:          "name",
+			Input:         "example",
+			ExpectedError: true,
+		},
+		{
+			Name:          "ID",
+			Input:         "123456789012:example",
+			ExpectedError: true,
+		},
+		{
+			Name:          "S3 ARN without account ID",
+			Input:         "arn:aws:s3:us-west-2::accesspoint/example", //lintignore:AWSAT003,AWSAT005
+			ExpectedError: true,
+		},
+		{
+			Name:          "S3 bucket ARN",
+			Input:         "arn:aws:s3:::example", //lintignore:AWSAT005
+			ExpectedError: true,
+		},
+		{
+			Name:          "S3 access point object ARN",
+			Input:         "arn:aws:s3:us-west-2:123456789012:accesspoint/example/object/key", //lintignore:AWSAT003,AWSAT005
+			ExpectedError: true,
+		},
+		{
+			Name:          "S3 on Outposts bucket ARN",
+			Input:         "arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/bucket/example", //lintignore:AWSAT003,AWSAT005
+			ExpectedError: true,
+		},
+		{
+			Name:          "other service ARN",
+			Input:         "arn:aws:sqs:us-west-2:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			accountID, name, err := tfs3control.AccessPointAccountIDAndNameFromARN(testCase.Input)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatalf("expected error, got %s, %s", accountID, name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if accountID != testCase.ExpectedAccountID {
+				t.Errorf("got account ID %s, expected %s", accountID, testCase.ExpectedAccountID)
+			}
+
+			if name != testCase.ExpectedName {
+				t.Errorf("got name %s, expected %s", name, testCase.ExpectedName)
+			}
+		})
+	}
+}
+
+func TestAccS3ControlAccessPointDataSource_name(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_access_point.test"
+	resourceName := "aws_s3_access_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPointDataSourceConfig_name(rName),
+				Check:  testAccCheckAccessPointDataSourceAttributes(dataSourceName, resourceName),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlAccessPointDataSource_arn(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_access_point.test"
+	resourceName := "aws_s3_access_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPointDataSourceConfig_arn(rName),
+				Check:  testAccCheckAccessPointDataSourceAttributes(dataSourceName, resourceName),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlAccessPointDataSource_Outposts_arn(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_access_point.test"
+	resourceName := "aws_s3_access_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPointDataSourceConfig_Outposts_arn(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "account_id", resourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bucket", resourceName, "bucket"),
+					resource.TestCheckResourceAttr(dataSourceName, "has_public_access_policy", "false"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "network_origin", resourceName, "network_origin"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_configuration.#", resourceName, "vpc_configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_configuration.0.vpc_id", resourceName, "vpc_configuration.0.vpc_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlAccessPointDataSource_ARN_otherRegion(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); acctest.PreCheckMultipleRegion(t, 2) },
+		ErrorCheck: acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAccessPointDataSourceConfig_otherRegionARN(),
+				ExpectError: regexp.MustCompile(`is not in the provider Region`),
+			},
+		},
+	})
+}
+
+func testAccCheckAccessPointDataSourceAttributes(dataSourceName, resourceName string) resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttrPair(dataSourceName, "account_id", resourceName, "account_id"),
+		resource.TestCheckResourceAttrSet(dataSourceName, "alias"),
+		resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+		resource.TestCheckResourceAttrPair(dataSourceName, "bucket", resourceName, "bucket"),
+		resource.TestCheckResourceAttrPair(dataSourceName, "creation_date", resourceName, "creation_date"),
+		resource.TestCheckResourceAttrPair(dataSourceName, "domain_name", resourceName, "domain_name"),
+		resource.TestCheckResourceAttrPair(dataSourceName, "has_public_access_policy", resourceName, "has_public_access_policy"),
+		resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+		resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+		resource.TestCheckResourceAttrPair(dataSourceName, "network_origin", resourceName, "network_origin"),
+		resource.TestCheckResourceAttrPair(dataSourceName, "policy", resourceName, "policy"),
+		resource.TestCheckResourceAttrPair(dataSourceName, "public_access_block_configuration.#", resourceName, "public_access_block_configuration.#"),
+		resource.TestCheckResourceAttrPair(dataSourceName, "public_access_block_configuration.0.block_public_acls", resourceName, "public_access_block_configuration.0.block_public_acls"),
+		resource.TestCheckResourceAttrPair(dataSourceName, "public_access_block_configuration.0.block_public_policy", resourceName, "public_access_block_configuration.0.block_public_policy"),
+		resource.TestCheckResourceAttrPair(dataSourceName, "vpc_configuration.#", resourceName, "vpc_configuration.#"),
+	)
+}
+
+func testAccAccessPointDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+}
+`, rName)
+}
+
+func testAccAccessPointDataSourceConfig_name(rName string) string {
+	return acctest.ConfigCompose(testAccAccessPointDataSourceConfig_base(rName), `
+data "aws_s3_access_point" "test" {
+  name = aws_s3_access_point.test.name
+}
+`)
+}
+
+func testAccAccessPointDataSourceConfig_arn(rName string) string {
+	return acctest.ConfigCompose(testAccAccessPointDataSourceConfig_base(rName), `
+data "aws_s3_access_point" "test" {
+  arn = aws_s3_access_point.test.arn
+}
+`)
+}
+
+func testAccAccessPointDataSourceConfig_Outposts_arn(rName string) string {
+	return acctest.ConfigCompose(testAccAccessPointConfig_Bucket_ARN(rName), `
+data "aws_s3_access_point" "test" {
+  arn = aws_s3_access_point.test.arn
+}
+`)
+}
+
+func testAccAccessPointDataSourceConfig_otherRegionARN() string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_s3_access_point" "test" {
+  arn = "arn:${data.aws_partition.current.partition}:s3:%[1]s:${data.aws_caller_identity.current.account_id}:accesspoint/example"
+}
+`, acctest.AlternateRegion())
+}
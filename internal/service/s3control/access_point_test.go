@@ -1,22 +1,525 @@
 package s3control_test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3control"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/stub"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	awspolicy "github.com/jen20/awspolicyequivalence"
 )
 
+func TestFindAccessPointByAccountIDAndName(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		NotFoundCount int
+		NewResource   bool
+		ExpectError   bool
+		ExpectCalls   int
+	}{
+		{
+			Name:        "found",
+			ExpectCalls: 1,
+		},
+		{
+			Name:          "not found",
+			NotFoundCount: 1,
+			ExpectError:   true,
+			ExpectCalls:   1,
+		},
+		{
+			Name:          "transient not found after create",
+			NotFoundCount: 2,
+			NewResource:   true,
+			ExpectCalls:   3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var calls int
+
+			conn := s3control.New(stub.Session(t))
+			stub.Send(conn.Client, func(r *request.Request) {
+				calls++
+
+				if calls <= testCase.NotFoundCount {
+					r.Error = awserr.New("NoSuchAccessPoint", "The specified accesspoint does not exist", nil)
+					return
+				}
+
+				data := r.Data.(*s3control.GetAccessPointOutput)
+				data.Name = aws.String("test")
+			})
+
+			_, err := tfresource.RetryWhenNewResourceNotFound(5*time.Second, func() (interface{}, error) {
+				return tfs3control.FindAccessPointByAccountIDAndName(conn, "123456789012", "test")
+			}, testCase.NewResource)
+
+			if testCase.ExpectError {
+				if !tfresource.NotFound(err) {
+					t.Fatalf("expected NotFoundError, got: %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if calls != testCase.ExpectCalls {
+				t.Errorf("expected %d GetAccessPoint calls, got %d", testCase.ExpectCalls, calls)
+			}
+		})
+	}
+}
+
+func TestAccessPointCreate_publicAccessBlockConfigurationNotYetAvailable(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"bucket": "test-bucket",
+		"name":   "test",
+		"public_access_block_configuration": []interface{}{map[string]interface{}{
+			"block_public_acls":       true,
+			"block_public_policy":     true,
+			"ignore_public_acls":      false,
+			"restrict_public_buckets": false,
+		}},
+	})
+
+	var getAccessPointCalls int
+
+	conn := s3control.New(stub.Session(t))
+	stub.Send(conn.Client, func(r *request.Request) {
+		switch output := r.Data.(type) {
+		case *s3control.CreateAccessPointOutput:
+			output.AccessPointArn = aws.String("arn:aws:s3:us-west-2:123456789012:accesspoint/test") //lintignore:AWSAT003,AWSAT005
+		case *s3control.GetAccessPointOutput:
+			getAccessPointCalls++
+
+			output.Bucket = aws.String("test-bucket")
+			output.Name = aws.String("test")
+			output.NetworkOrigin = aws.String(tfs3control.NetworkOriginInternet)
+
+			if getAccessPointCalls > 1 {
+				output.PublicAccessBlockConfiguration = &s3control.PublicAccessBlockConfiguration{
+					BlockPublicAcls:       aws.Bool(true),
+					BlockPublicPolicy:     aws.Bool(true),
+					IgnorePublicAcls:      aws.Bool(false),
+					RestrictPublicBuckets: aws.Bool(false),
+				}
+			}
+		case *s3control.GetAccessPointPolicyOutput, *s3control.GetAccessPointPolicyStatusOutput:
+			r.Error = awserr.New("NoSuchAccessPointPolicy", "The specified accesspoint policy does not exist", nil)
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccountID:     "123456789012",
+		DNSSuffix:     "amazonaws.com",
+		Partition:     endpoints.AwsPartitionID,
+		Region:        endpoints.UsWest2RegionID,
+		S3ControlConn: conn,
+	}
+	r := tfs3control.ResourceAccessPoint()
+
+	diff, err := r.Diff(context.Background(), nil, config, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	state, diags := r.Apply(context.Background(), nil, diff, meta)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, expected := getAccessPointCalls, 2; got != expected {
+		t.Errorf("got %d GetAccessPoint calls, expected %d", got, expected)
+	}
+
+	for k, expected := range map[string]string{
+		"public_access_block_configuration.#":                         "1",
+		"public_access_block_configuration.0.block_public_acls":       "true",
+		"public_access_block_configuration.0.block_public_policy":     "true",
+		"public_access_block_configuration.0.ignore_public_acls":      "false",
+		"public_access_block_configuration.0.restrict_public_buckets": "false",
+	} {
+		if got := state.Attributes[k]; got != expected {
+			t.Errorf("got %s %q, expected %q", k, got, expected)
+		}
+	}
+}
+
+func TestAccessPointCreate_alreadyExists(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"bucket": "test-bucket",
+		"name":   "test",
+	})
+
+	var operations []string
+
+	conn := s3control.New(stub.Session(t))
+	stub.Send(conn.Client, func(r *request.Request) {
+		operations = append(operations, r.Operation.Name)
+
+		if _, ok := r.Data.(*s3control.CreateAccessPointOutput); ok {
+			r.Error = awserr.NewRequestFailure(awserr.New("AccessPointAlreadyOwnedByYou", "Your previous request to create the named accesspoint succeeded and you already own it.", nil), http.StatusConflict, "")
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccountID:     "123456789012",
+		DNSSuffix:     "amazonaws.com",
+		Partition:     endpoints.AwsPartitionID,
+		Region:        endpoints.UsWest2RegionID,
+		S3ControlConn: conn,
+	}
+	r := tfs3control.ResourceAccessPoint()
+
+	diff, err := r.Diff(context.Background(), nil, config, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, diags := r.Apply(context.Background(), nil, diff, meta)
+
+	if !diags.HasError() {
+		t.Fatal("expected error, got none")
+	}
+
+	if got, expected := diags[0].Summary, regexp.MustCompile(`terraform import.*ID 123456789012:test(.|\n)*AccessPointAlreadyOwnedByYou`); !expected.MatchString(got) {
+		t.Errorf("unexpected error: %s", got)
+	}
+
+	if got, expected := fmt.Sprint(operations), fmt.Sprint([]string{"CreateAccessPoint"}); got != expected {
+		t.Errorf("got operations %s, expected %s", got, expected)
+	}
+}
+
+func TestAccessPointCustomizeDiff_networkOrigin(t *testing.T) {
+	testCases := []struct {
+		Name                  string
+		PriorNetworkOrigin    string
+		NetworkOrigin         string
+		VpcConfiguration      bool
+		ExpectedNetworkOrigin string
+		ExpectedError         *regexp.Regexp
+	}{
+		{
+			Name:                  "internet computed",
+			ExpectedNetworkOrigin: tfs3control.NetworkOriginInternet,
+		},
+		{
+			Name:                  "VPC computed",
+			VpcConfiguration:      true,
+			ExpectedNetworkOrigin: tfs3control.NetworkOriginVPC,
+		},
+		{
+			Name:                  "internet configured",
+			NetworkOrigin:         tfs3control.NetworkOriginInternet,
+			ExpectedNetworkOrigin: tfs3control.NetworkOriginInternet,
+		},
+		{
+			Name:                  "VPC configured",
+			NetworkOrigin:         "Vpc",
+			VpcConfiguration:      true,
+			ExpectedNetworkOrigin: "Vpc",
+		},
+		{
+			Name:          "VPC configured without vpc_configuration",
+			NetworkOrigin: tfs3control.NetworkOriginVPC,
+			ExpectedError: regexp.MustCompile(`network_origin \(VPC\) requires vpc_configuration`),
+		},
+		{
+			Name:             "internet configured with vpc_configuration",
+			NetworkOrigin:    tfs3control.NetworkOriginInternet,
+			VpcConfiguration: true,
+			ExpectedError:    regexp.MustCompile(`network_origin \(Internet\) cannot be used with vpc_configuration`),
+		},
+		{
+			Name:               "existing internet access point",
+			PriorNetworkOrigin: tfs3control.NetworkOriginInternet,
+		},
+		{
+			Name:               "VPC configured on existing internet access point",
+			PriorNetworkOrigin: tfs3control.NetworkOriginInternet,
+			NetworkOrigin:      tfs3control.NetworkOriginVPC,
+			ExpectedError:      regexp.MustCompile(`network_origin \(VPC\) requires vpc_configuration`),
+		},
+		{
+			Name:                  "vpc_configuration removed from existing VPC access point",
+			PriorNetworkOrigin:    tfs3control.NetworkOriginVPC,
+			ExpectedNetworkOrigin: tfs3control.NetworkOriginInternet,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"bucket": "test",
+				"name":   "test",
+			}
+
+			if testCase.NetworkOrigin != "" {
+				raw["network_origin"] = testCase.NetworkOrigin
+			}
+
+			if testCase.VpcConfiguration {
+				raw["vpc_configuration"] = []interface{}{
+					map[string]interface{}{
+						"vpc_id": "vpc-12345678",
+					},
+				}
+			}
+
+			var state *terraform.InstanceState
+
+			if testCase.PriorNetworkOrigin != "" {
+				state = &terraform.InstanceState{
+					ID: "123456789012:test",
+					Attributes: map[string]string{
+						"account_id":     "123456789012",
+						"bucket":         "test",
+						"id":             "123456789012:test",
+						"name":           "test",
+						"network_origin": testCase.PriorNetworkOrigin,
+					},
+				}
+
+				if testCase.PriorNetworkOrigin == tfs3control.NetworkOriginVPC {
+					state.Attributes["vpc_configuration.#"] = "1"
+					state.Attributes["vpc_configuration.0.vpc_id"] = "vpc-12345678"
+				}
+			}
+
+			diff, err := tfs3control.ResourceAccessPoint().Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), &conns.AWSClient{})
+
+			if testCase.ExpectedError != nil {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				if !testCase.ExpectedError.MatchString(err.Error()) {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedNetworkOrigin == "" {
+				if diff != nil && diff.Attributes["network_origin"] != nil {
+					t.Errorf("expected no network_origin change, got %#v", diff.Attributes["network_origin"])
+				}
+
+				return
+			}
+
+			if got, want := diff.Attributes["network_origin"].New, testCase.ExpectedNetworkOrigin; got != want {
+				t.Errorf("expected network_origin %q, got %q", want, got)
+			}
+		})
+	}
+}
+
+func TestAccessPointCustomizeDiff_outpostsAccountID(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		AccountID     string
+		Bucket        string
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			Name:   "Outposts bucket without account_id",
+			Bucket: "arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01234567890123456/bucket/test", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name:      "Outposts bucket with matching account_id",
+			AccountID: "123456789012",
+			Bucket:    "arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01234567890123456/bucket/test", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name:          "Outposts bucket with mismatched account_id",
+			AccountID:     "210987654321",
+			Bucket:        "arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01234567890123456/bucket/test", //lintignore:AWSAT003,AWSAT005
+			ExpectedError: regexp.MustCompile(`account_id \(210987654321\) does not match the account ID \(123456789012\)`),
+		},
+		{
+			Name:      "S3 bucket with account_id",
+			AccountID: "210987654321",
+			Bucket:    "test",
+		},
+		{
+			Name:      "S3 bucket ARN with account_id",
+			AccountID: "210987654321",
+			Bucket:    "arn:aws:s3:::test", //lintignore:AWSAT005
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"bucket": testCase.Bucket,
+				"name":   "test",
+			}
+
+			if testCase.AccountID != "" {
+				raw["account_id"] = testCase.AccountID
+			}
+
+			_, err := tfs3control.ResourceAccessPoint().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), &conns.AWSClient{})
+
+			if testCase.ExpectedError != nil {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				if !testCase.ExpectedError.MatchString(err.Error()) {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestAccessPointBucket(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Input         string
+		Expected      string
+		ExpectedError bool
+	}{
+		{
+			Name:     "name",
+			Input:    "example",
+			Expected: "example",
+		},
+		{
+			Name:     "S3 ARN",
+			Input:    "arn:aws:s3:::example",
+			Expected: "example",
+		},
+		{
+			Name:     "S3 ARN GovCloud",
+			Input:    "arn:aws-us-gov:s3:::example",
+			Expected: "example",
+		},
+		{
+			Name:     "S3 on Outposts ARN",
+			Input:    "arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/bucket/example",
+			Expected: "arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/bucket/example",
+		},
+		{
+			Name:          "empty",
+			Input:         "",
+			ExpectedError: true,
+		},
+		{
+			Name:          "S3 object ARN",
+			Input:         "arn:aws:s3:::example/key",
+			ExpectedError: true,
+		},
+		{
+			Name:          "S3 on Outposts access point ARN",
+			Input:         "arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/accesspoint/example",
+			ExpectedError: true,
+		},
+		{
+			Name:          "other service ARN",
+			Input:         "arn:aws:sqs:us-west-2:123456789012:example",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := tfs3control.AccessPointBucket(testCase.Input)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatalf("expected error, got %s", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAccessPointDomainName(t *testing.T) {
+	testCases := []struct {
+		Region   string
+		Expected string
+	}{
+		{
+			Region:   endpoints.UsWest2RegionID,
+			Expected: "example-123456789012.s3-accesspoint.us-west-2.amazonaws.com",
+		},
+		{
+			Region:   endpoints.UsGovWest1RegionID,
+			Expected: "example-123456789012.s3-accesspoint.us-gov-west-1.amazonaws.com",
+		},
+		{
+			Region:   endpoints.CnNorth1RegionID,
+			Expected: "example-123456789012.s3-accesspoint.cn-north-1.amazonaws.com.cn",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Region, func(t *testing.T) {
+			partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), testCase.Region)
+
+			if !ok {
+				t.Fatalf("partition not found for region %s", testCase.Region)
+			}
+
+			client := &conns.AWSClient{
+				DNSSuffix: partition.DNSSuffix(),
+				Partition: partition.ID(),
+				Region:    testCase.Region,
+			}
+
+			got := tfs3control.AccessPointDomainName(client, "example", "123456789012")
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestAccS3ControlAccessPoint_basic(t *testing.T) {
 	var v s3control.GetAccessPointOutput
 	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -36,10 +539,11 @@ func TestAccS3ControlAccessPoint_basic(t *testing.T) {
 					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
 					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "s3", fmt.Sprintf("accesspoint/%s", accessPointName)),
 					resource.TestCheckResourceAttr(resourceName, "bucket", bucketName),
+					acctest.CheckResourceAttrRFC3339(resourceName, "creation_date"),
 					acctest.MatchResourceAttrRegionalHostname(resourceName, "domain_name", "s3-accesspoint", regexp.MustCompile(fmt.Sprintf("^%s-\\d{12}", accessPointName))),
 					resource.TestCheckResourceAttr(resourceName, "has_public_access_policy", "false"),
 					resource.TestCheckResourceAttr(resourceName, "name", accessPointName),
-					resource.TestCheckResourceAttr(resourceName, "network_origin", "Internet"),
+					resource.TestCheckResourceAttr(resourceName, "network_origin", tfs3control.NetworkOriginInternet),
 					resource.TestCheckResourceAttr(resourceName, "policy", ""),
 					resource.TestCheckResourceAttr(resourceName, "public_access_block_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "public_access_block_configuration.0.block_public_acls", "true"),
@@ -148,6 +652,40 @@ func TestAccS3ControlAccessPoint_Bucket_arn(t *testing.T) {
 	})
 }
 
+func TestAccS3ControlAccessPoint_Bucket_s3ARN(t *testing.T) {
+	var v s3control.GetAccessPointOutput
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	accessPointName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_access_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPointConfig_Bucket_s3ARN(bucketName, accessPointName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "bucket", bucketName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "s3", fmt.Sprintf("accesspoint/%s", accessPointName)),
+				),
+			},
+			{
+				// The name form of the same bucket must not produce a diff.
+				Config:   testAccAccessPointConfig_basic(bucketName, accessPointName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccS3ControlAccessPoint_policy(t *testing.T) {
 	var v s3control.GetAccessPointOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -209,7 +747,7 @@ func TestAccS3ControlAccessPoint_policy(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
 					resource.TestCheckResourceAttr(resourceName, "has_public_access_policy", "true"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
-					resource.TestCheckResourceAttr(resourceName, "network_origin", "Internet"),
+					resource.TestCheckResourceAttr(resourceName, "network_origin", tfs3control.NetworkOriginInternet),
 					resource.TestCheckResourceAttr(resourceName, "public_access_block_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "public_access_block_configuration.0.block_public_acls", "true"),
 					resource.TestCheckResourceAttr(resourceName, "public_access_block_configuration.0.block_public_policy", "false"),
@@ -238,6 +776,78 @@ func TestAccS3ControlAccessPoint_policy(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "policy", ""),
 				),
 			},
+			{
+				// An explicitly empty policy is equivalent to a deleted one.
+				Config:   testAccAccessPointConfig_emptyPolicy(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ControlAccessPoint_Policy_import(t *testing.T) {
+	var v s3control.GetAccessPointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_access_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPointConfig_policy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPointExists(resourceName, &v),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					if len(s) != 1 {
+						return fmt.Errorf("expected 1 state: %#v", s)
+					}
+
+					if v := s[0].Attributes["creation_date"]; v == "" {
+						return fmt.Errorf("expected creation_date to be set for S3 Access Point (%s)", s[0].ID)
+					}
+
+					if v := s[0].Attributes["has_public_access_policy"]; v != "true" {
+						return fmt.Errorf("expected has_public_access_policy to be true for S3 Access Point (%s), got %q", s[0].ID, v)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccS3ControlAccessPoint_Outposts_policy(t *testing.T) {
+	var v s3control.GetAccessPointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_access_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPointConfig_Outposts_policy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPointExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -262,7 +872,7 @@ func TestAccS3ControlAccessPoint_publicAccessBlock(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
 					resource.TestCheckResourceAttr(resourceName, "has_public_access_policy", "false"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
-					resource.TestCheckResourceAttr(resourceName, "network_origin", "Internet"),
+					resource.TestCheckResourceAttr(resourceName, "network_origin", tfs3control.NetworkOriginInternet),
 					resource.TestCheckResourceAttr(resourceName, "policy", ""),
 					resource.TestCheckResourceAttr(resourceName, "public_access_block_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "public_access_block_configuration.0.block_public_acls", "false"),
@@ -302,7 +912,7 @@ func TestAccS3ControlAccessPoint_vpc(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
 					resource.TestCheckResourceAttr(resourceName, "has_public_access_policy", "false"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
-					resource.TestCheckResourceAttr(resourceName, "network_origin", "VPC"),
+					resource.TestCheckResourceAttr(resourceName, "network_origin", tfs3control.NetworkOriginVPC),
 					resource.TestCheckResourceAttr(resourceName, "policy", ""),
 					resource.TestCheckResourceAttr(resourceName, "public_access_block_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "public_access_block_configuration.0.block_public_acls", "true"),
@@ -322,6 +932,57 @@ func TestAccS3ControlAccessPoint_vpc(t *testing.T) {
 	})
 }
 
+func TestAccS3ControlAccessPoint_VPC_policyPreserved(t *testing.T) {
+	var v1, v2 s3control.GetAccessPointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_access_point.test"
+
+	expectedPolicyText := func() string {
+		return fmt.Sprintf(`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "AWS": "*"
+      },
+      "Action": "s3:GetObjectTagging",
+      "Resource": [
+        "arn:%s:s3:%s:%s:accesspoint/%s/object/*"
+      ]
+    }
+  ]
+}`, acctest.Partition(), acctest.Region(), acctest.AccountID(), rName)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPointConfig_vpcPolicy(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPointExists(resourceName, &v1),
+					testAccCheckAccessPointHasPolicy(resourceName, expectedPolicyText),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_configuration.0.vpc_id", "aws_vpc.test.0", "id"),
+				),
+			},
+			{
+				Config: testAccAccessPointConfig_vpcPolicy(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPointExists(resourceName, &v2),
+					testAccCheckAccessPointRecreated(&v1, &v2),
+					testAccCheckAccessPointHasPolicy(resourceName, expectedPolicyText),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_configuration.0.vpc_id", "aws_vpc.test.1", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAccessPointDisappears(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -352,6 +1013,16 @@ func testAccCheckAccessPointDisappears(n string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckAccessPointRecreated(before, after *s3control.GetAccessPointOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.TimeValue(before.CreationDate).Equal(aws.TimeValue(after.CreationDate)) {
+			return fmt.Errorf("S3 Access Point not recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAccessPointDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn
 
@@ -463,6 +1134,19 @@ resource "aws_s3_access_point" "test" {
 `, bucketName, accessPointName)
 }
 
+func testAccAccessPointConfig_Bucket_s3ARN(bucketName, accessPointName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3_bucket.test.arn
+  name   = %[2]q
+}
+`, bucketName, accessPointName)
+}
+
 func testAccAccessPointConfig_Bucket_ARN(rName string) string {
 	return fmt.Sprintf(`
 data "aws_outposts_outposts" "test" {}
@@ -495,6 +1179,62 @@ resource "aws_s3_access_point" "test" {
 `, rName)
 }
 
+func testAccAccessPointConfig_Outposts_policy(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_outposts_outposts" "test" {}
+
+data "aws_outposts_outpost" "test" {
+  id = tolist(data.aws_outposts_outposts.test.ids)[0]
+}
+
+resource "aws_s3control_bucket" "test" {
+  bucket     = %[1]q
+  outpost_id = data.aws_outposts_outpost.test.id
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3control_bucket.test.arn
+  name   = %[1]q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action = [
+        "S3-Outposts:GetObject",
+        "s3-outposts:PutObject",
+      ]
+      Resource = [
+        "arn:${data.aws_partition.current.partition}:s3-outposts:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:outpost/${data.aws_outposts_outpost.test.id}/accesspoint/%[1]s/object/*",
+        "arn:${data.aws_partition.current.partition}:s3-outposts:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:outpost/${data.aws_outposts_outpost.test.id}/accesspoint/%[1]s",
+      ]
+    }]
+  })
+
+  vpc_configuration {
+    vpc_id = aws_vpc.test.id
+  }
+}
+`, rName)
+}
+
 func testAccAccessPointConfig_policy(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -584,6 +1324,27 @@ data "aws_iam_policy_document" "test" {
 `, rName)
 }
 
+func testAccAccessPointConfig_emptyPolicy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+  policy = ""
+
+  public_access_block_configuration {
+    block_public_acls       = true
+    block_public_policy     = false
+    ignore_public_acls      = true
+    restrict_public_buckets = false
+  }
+}
+`, rName)
+}
+
 func testAccAccessPointConfig_noPolicy(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -649,6 +1410,57 @@ resource "aws_s3_access_point" "test" {
 `, rName)
 }
 
+func testAccAccessPointConfig_vpcPolicy(rName string, vpcIndex int) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  count = 2
+
+  cidr_block = "10.${count.index}.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+  policy = data.aws_iam_policy_document.test.json
+
+  vpc_configuration {
+    vpc_id = aws_vpc.test[%[2]d].id
+  }
+}
+
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    effect = "Allow"
+
+    actions = [
+      "s3:GetObjectTagging",
+    ]
+
+    resources = [
+      "arn:${data.aws_partition.current.partition}:s3:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:accesspoint/%[1]s/object/*",
+    ]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["*"]
+    }
+  }
+}
+`, rName, vpcIndex)
+}
+
 func testAccCheckDestroyBucket(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
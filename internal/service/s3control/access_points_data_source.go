@@ -0,0 +1,123 @@
+package s3control
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceAccessPoints() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAccessPointsRead,
+
+		Schema: map[string]*schema.Schema{
+			"access_points": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_origin": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func dataSourceAccessPointsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	bucket := d.Get("bucket").(string)
+
+	var accountID string
+
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	} else if parsedARN, err := arn.Parse(bucket); err == nil {
+		// S3 on Outposts buckets are identified by ARN, which includes the owning account.
+		accountID = parsedARN.AccountID
+	} else {
+		accountID = meta.(*conns.AWSClient).AccountID
+	}
+
+	accessPoints, err := FindAccessPointsByAccountIDAndBucket(conn, accountID, bucket)
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 Access Points (%s): %w", bucket, err)
+	}
+
+	if _, err := arn.Parse(bucket); err == nil {
+		d.SetId(bucket)
+	} else {
+		d.SetId(fmt.Sprintf("%s:%s", accountID, bucket))
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("bucket", bucket)
+
+	if err := d.Set("access_points", flattenAccessPoints(accessPoints)); err != nil {
+		return fmt.Errorf("error setting access_points: %w", err)
+	}
+
+	return nil
+}
+
+func flattenAccessPoints(apiObjects []*s3control.AccessPoint) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	sort.Slice(apiObjects, func(i, j int) bool {
+		return aws.StringValue(apiObjects[i].Name) < aws.StringValue(apiObjects[j].Name)
+	})
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"alias":          aws.StringValue(apiObject.Alias),
+			"arn":            aws.StringValue(apiObject.AccessPointArn),
+			"name":           aws.StringValue(apiObject.Name),
+			"network_origin": aws.StringValue(apiObject.NetworkOrigin),
+		})
+	}
+
+	return tfList
+}
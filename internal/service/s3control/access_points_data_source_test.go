@@ -0,0 +1,109 @@
+package s3control_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccS3ControlAccessPointsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_access_points.test"
+	resourceName1 := "aws_s3_access_point.test1"
+	resourceName2 := "aws_s3_access_point.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPointsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, "account_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "access_points.#", "2"),
+					// Access points are sorted by name.
+					resource.TestCheckResourceAttrSet(dataSourceName, "access_points.0.alias"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_points.0.arn", resourceName2, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_points.0.name", resourceName2, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "access_points.0.network_origin", "Internet"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_points.1.arn", resourceName1, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_points.1.name", resourceName1, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "access_points.1.network_origin", "VPC"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlAccessPointsDataSource_empty(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_access_points.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPointsDataSourceEmptyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "access_points.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessPointsDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_s3_access_point" "test1" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = "%[1]s-b"
+
+  vpc_configuration {
+    vpc_id = aws_vpc.test.id
+  }
+}
+
+resource "aws_s3_access_point" "test2" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = "%[1]s-a"
+}
+
+data "aws_s3_access_points" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  depends_on = [aws_s3_access_point.test1, aws_s3_access_point.test2]
+}
+`, rName)
+}
+
+func testAccAccessPointsDataSourceEmptyConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+data "aws_s3_access_points" "test" {
+  bucket = aws_s3_bucket.test.bucket
+}
+`, rName)
+}
@@ -0,0 +1,101 @@
+package s3control
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// suppressEquivalentAccessPointPolicyDiffs suppresses differences between equivalent Access Point policies.
+// In addition to the checks made by verify.SuppressEquivalentPolicyDiffs, action service prefixes are
+// compared case-insensitively as S3 on Outposts may return e.g. "s3-outposts:GetObject" for "S3-Outposts:GetObject".
+func suppressEquivalentAccessPointPolicyDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if accessPointPolicyIsEmpty(old) && accessPointPolicyIsEmpty(new) {
+		return true
+	}
+
+	if verify.SuppressEquivalentPolicyDiffs(k, old, new, d) {
+		return true
+	}
+
+	old, err := normalizeAccessPointPolicyActions(old)
+
+	if err != nil {
+		return false
+	}
+
+	new, err = normalizeAccessPointPolicyActions(new)
+
+	if err != nil {
+		return false
+	}
+
+	return verify.SuppressEquivalentPolicyDiffs(k, old, new, d)
+}
+
+// accessPointPolicyIsEmpty returns whether the specified Access Point policy is absent.
+// An omitted policy, a deleted policy and an empty JSON object all mean no policy.
+func accessPointPolicyIsEmpty(policy string) bool {
+	policy = strings.TrimSpace(policy)
+
+	return policy == "" || policy == "{}"
+}
+
+// normalizeAccessPointPolicyActions returns the policy with the service prefix of each action lowercased.
+func normalizeAccessPointPolicyActions(policy string) (string, error) {
+	var v map[string]interface{}
+
+	if err := json.Unmarshal([]byte(policy), &v); err != nil {
+		return "", err
+	}
+
+	var statements []interface{}
+
+	switch s := v["Statement"].(type) {
+	case []interface{}:
+		statements = s
+	case map[string]interface{}:
+		statements = []interface{}{s}
+	}
+
+	for _, statement := range statements {
+		statement, ok := statement.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for _, key := range []string{"Action", "NotAction"} {
+			switch actions := statement[key].(type) {
+			case string:
+				statement[key] = normalizeAccessPointPolicyAction(actions)
+			case []interface{}:
+				for i, action := range actions {
+					if action, ok := action.(string); ok {
+						actions[i] = normalizeAccessPointPolicyAction(action)
+					}
+				}
+			}
+		}
+	}
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func normalizeAccessPointPolicyAction(action string) string {
+	parts := strings.SplitN(action, ":", 2)
+
+	if len(parts) != 2 {
+		return action
+	}
+
+	return strings.ToLower(parts[0]) + ":" + parts[1]
+}
@@ -0,0 +1,74 @@
+package s3control
+
+import (
+	"testing"
+)
+
+func TestSuppressEquivalentAccessPointPolicyDiffs(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Old        string
+		New        string
+		Equivalent bool
+	}{
+		{
+			Name:       "identical",
+			Old:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3-outposts:GetObject","Resource":"arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/accesspoint/example/object/*"}]}`,
+			New:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3-outposts:GetObject","Resource":"arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/accesspoint/example/object/*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "Outposts action service prefix casing",
+			Old:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":["s3-outposts:GetObject","s3-outposts:PutObject"],"Resource":"arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/accesspoint/example/object/*"}]}`,
+			New:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":["S3-Outposts:GetObject","s3-outposts:PutObject"],"Resource":"arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/accesspoint/example/object/*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "single statement object",
+			Old:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"NotAction":"s3-outposts:DeleteObject","Resource":"*"}]}`,
+			New:        `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"NotAction":"S3-OUTPOSTS:DeleteObject","Resource":"*"}}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "resource ordering",
+			Old:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3-outposts:GetObject","Resource":["arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/accesspoint/example","arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/accesspoint/example/object/*"]}]}`,
+			New:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3-outposts:GetObject","Resource":["arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/accesspoint/example/object/*","arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/accesspoint/example"]}]}`,
+			Equivalent: true,
+		},
+		{
+			Name: "different action",
+			Old:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3-outposts:GetObject","Resource":"*"}]}`,
+			New:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3-outposts:PutObject","Resource":"*"}]}`,
+		},
+		{
+			Name:       "absent",
+			Old:        "",
+			New:        "",
+			Equivalent: true,
+		},
+		{
+			Name:       "empty object and absent",
+			Old:        "{}",
+			New:        "",
+			Equivalent: true,
+		},
+		{
+			Name: "absent and policy",
+			Old:  "",
+			New:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3-outposts:GetObject","Resource":"*"}]}`,
+		},
+		{
+			Name: "invalid JSON",
+			Old:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3-outposts:GetObject","Resource":"*"}]}`,
+			New:  `{"Version":"2012-10-17","Statement":[`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got, want := suppressEquivalentAccessPointPolicyDiffs("policy", testCase.Old, testCase.New, nil), testCase.Equivalent; got != want {
+				t.Errorf("expected %t, got %t", want, got)
+			}
+		})
+	}
+}
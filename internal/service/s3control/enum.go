@@ -0,0 +1,11 @@
+package s3control
+
+import (
+	"github.com/aws/aws-sdk-go/service/s3control"
+)
+
+// Network origins of an S3 Access Point, as returned in the network_origin attribute.
+const (
+	NetworkOriginInternet = s3control.NetworkOriginInternet
+	NetworkOriginVPC      = s3control.NetworkOriginVpc
+)
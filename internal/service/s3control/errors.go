@@ -1,9 +1,42 @@
 package s3control
 
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
 // Error code constants missing from AWS Go SDK:
 // https://docs.aws.amazon.com/sdk-for-go/api/service/s3control/#pkg-constants
 //nolint:deadcode,varcheck // These constants are missing from the AWS SDK
 const (
-	errCodeNoSuchAccessPoint       = "NoSuchAccessPoint"
-	errCodeNoSuchAccessPointPolicy = "NoSuchAccessPointPolicy"
+	errCodeAccessPointAlreadyOwnedByYou = "AccessPointAlreadyOwnedByYou"
+	errCodeInvalidRequest               = "InvalidRequest"
+	errCodeNoSuchAccessPoint            = "NoSuchAccessPoint"
+	errCodeNoSuchAccessPointPolicy      = "NoSuchAccessPointPolicy"
+	errCodeNoSuchMultiRegionAccessPoint = "NoSuchMultiRegionAccessPoint"
 )
+
+// accessPointInvalidVPCError returns a descriptive error if err is the 400 InvalidRequest
+// S3 Control returns when an access point's VPC configuration references a VPC it
+// cannot find, which names the VPC ID, otherwise nil.
+func accessPointInvalidVPCError(err error, vpcID string) error {
+	if err == nil || vpcID == "" {
+		return nil
+	}
+
+	var reqErr awserr.RequestFailure
+
+	if !errors.As(err, &reqErr) || reqErr.StatusCode() != http.StatusBadRequest || reqErr.Code() != errCodeInvalidRequest {
+		return nil
+	}
+
+	if !strings.Contains(strings.ToLower(reqErr.Message()), strings.ToLower(vpcID)) {
+		return nil
+	}
+
+	return fmt.Errorf("VPC (%s) not found, it must exist in the same account and region as the access point: %w", vpcID, err)
+}
@@ -0,0 +1,84 @@
+package s3control
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func TestAccessPointInvalidVPCError(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Err           error
+		VpcID         string
+		ExpectedError string
+	}{
+		{
+			Name:  "no error",
+			VpcID: "vpc-12345678",
+		},
+		{
+			Name: "no VPC",
+			Err:  awserr.NewRequestFailure(awserr.New("InvalidRequest", "Invalid VPC configuration", nil), http.StatusBadRequest, "req"),
+		},
+		{
+			Name:  "not a request failure",
+			Err:   errors.New("VPC error"),
+			VpcID: "vpc-12345678",
+		},
+		{
+			Name:  "not a bad request",
+			Err:   awserr.NewRequestFailure(awserr.New("InternalError", "VPC lookup failed", nil), http.StatusInternalServerError, "req"),
+			VpcID: "vpc-12345678",
+		},
+		{
+			Name:  "unrelated bad request",
+			Err:   awserr.NewRequestFailure(awserr.New("InvalidRequest", "Bucket does not exist", nil), http.StatusBadRequest, "req"),
+			VpcID: "vpc-12345678",
+		},
+		{
+			Name:  "unrelated VPC configuration error",
+			Err:   awserr.NewRequestFailure(awserr.New("InvalidRequest", "VPC configuration is not supported for this bucket", nil), http.StatusBadRequest, "req"),
+			VpcID: "vpc-12345678",
+		},
+		{
+			Name:  "other error code naming the VPC ID",
+			Err:   awserr.NewRequestFailure(awserr.New("AccessDenied", "Not authorized to use vpc-12345678", nil), http.StatusBadRequest, "req"),
+			VpcID: "vpc-12345678",
+		},
+		{
+			Name:          "invalid VPC",
+			Err:           awserr.NewRequestFailure(awserr.New("InvalidRequest", "The VPC ID vpc-12345678 is invalid", nil), http.StatusBadRequest, "req"),
+			VpcID:         "vpc-12345678",
+			ExpectedError: "VPC (vpc-12345678) not found, it must exist in the same account and region as the access point: InvalidRequest: The VPC ID vpc-12345678 is invalid\n\tstatus code: 400, request id: req",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := accessPointInvalidVPCError(testCase.Err, testCase.VpcID)
+
+			if testCase.ExpectedError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.ExpectedError)
+			}
+
+			if got := err.Error(); got != testCase.ExpectedError {
+				t.Errorf("got error %q, expected %q", got, testCase.ExpectedError)
+			}
+
+			if !errors.Is(err, testCase.Err) {
+				t.Errorf("expected error to wrap %q", testCase.Err)
+			}
+		})
+	}
+}
@@ -0,0 +1,321 @@
+package s3control
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceMultiRegionAccessPointPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMultiRegionAccessPointPolicyCreate,
+		Read:   resourceMultiRegionAccessPointPolicyRead,
+		Update: resourceMultiRegionAccessPointPolicyUpdate,
+		Delete: resourceMultiRegionAccessPointPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"details": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 50),
+						},
+						"policy": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+						},
+					},
+				},
+			},
+			"established": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"proposed": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMultiRegionAccessPointPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := ConnForMRAP(meta.(*conns.AWSClient))
+
+	if err != nil {
+		return err
+	}
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	details := expandMultiRegionAccessPointPolicyDetails(d.Get("details").([]interface{})[0].(map[string]interface{}))
+	name := aws.StringValue(details.Name)
+
+	if err := putMultiRegionAccessPointPolicy(conn, accountID, details, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error creating S3 Multi-Region Access Point (%s) Policy: %w", name, err)
+	}
+
+	d.SetId(MultiRegionAccessPointCreateResourceID(accountID, name))
+
+	return resourceMultiRegionAccessPointPolicyRead(d, meta)
+}
+
+func resourceMultiRegionAccessPointPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn, err := ConnForMRAP(meta.(*conns.AWSClient))
+
+	if err != nil {
+		return err
+	}
+
+	accountID, name, err := MultiRegionAccessPointParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	policyDocument, err := FindMultiRegionAccessPointPolicyDocumentByAccountIDAndName(conn, accountID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Multi-Region Access Point Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Multi-Region Access Point Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_id", accountID)
+
+	var established, proposed string
+
+	if v := policyDocument.Established; v != nil {
+		established = aws.StringValue(v.Policy)
+	}
+
+	if v := policyDocument.Proposed; v != nil {
+		proposed = aws.StringValue(v.Policy)
+	}
+
+	d.Set("established", established)
+	d.Set("proposed", proposed)
+
+	// The proposed policy is the most recently requested one.
+	policy := proposed
+	if policy == "" {
+		policy = established
+	}
+
+	if err := d.Set("details", []interface{}{map[string]interface{}{"name": name, "policy": policy}}); err != nil {
+		return fmt.Errorf("error setting details: %w", err)
+	}
+
+	return nil
+}
+
+func resourceMultiRegionAccessPointPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := ConnForMRAP(meta.(*conns.AWSClient))
+
+	if err != nil {
+		return err
+	}
+
+	accountID, _, err := MultiRegionAccessPointParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	details := expandMultiRegionAccessPointPolicyDetails(d.Get("details").([]interface{})[0].(map[string]interface{}))
+
+	if err := putMultiRegionAccessPointPolicy(conn, accountID, details, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error updating S3 Multi-Region Access Point Policy (%s): %w", d.Id(), err)
+	}
+
+	return resourceMultiRegionAccessPointPolicyRead(d, meta)
+}
+
+// resourceMultiRegionAccessPointPolicyDelete replaces the policy with one that denies all access
+// through the Multi-Region Access Point, as Multi-Region Access Point policies cannot be deleted.
+func resourceMultiRegionAccessPointPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn, err := ConnForMRAP(meta.(*conns.AWSClient))
+
+	if err != nil {
+		return err
+	}
+
+	accountID, name, err := MultiRegionAccessPointParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	accessPoint, err := FindMultiRegionAccessPointByAccountIDAndName(conn, accountID, name)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Multi-Region Access Point (%s): %w", name, err)
+	}
+
+	accessPointARN := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "s3",
+		AccountID: accountID,
+		Resource:  fmt.Sprintf("accesspoint/%s", aws.StringValue(accessPoint.Alias)),
+	}.String()
+
+	details := &s3control.PutMultiRegionAccessPointPolicyInput_{
+		Name:   aws.String(name),
+		Policy: aws.String(multiRegionAccessPointDenyAllPolicy(accessPointARN)),
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Multi-Region Access Point Policy (%s) by denying all access", d.Id())
+	err = putMultiRegionAccessPointPolicy(conn, accountID, details, d.Timeout(schema.TimeoutDelete))
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Multi-Region Access Point Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// ConnForMRAP returns an S3 Control connection for Multi-Region Access Point operations,
+// which are all routed to the US West (Oregon) Region.
+func ConnForMRAP(client *conns.AWSClient) (*s3control.S3Control, error) {
+	originalConn := client.S3ControlConn
+	region := endpoints.UsWest2RegionID
+
+	if aws.StringValue(originalConn.Config.Region) == region {
+		return originalConn, nil
+	}
+
+	sess, err := conns.NewSessionForRegion(&originalConn.Config, region, client.TerraformVersion)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS session: %w", err)
+	}
+
+	return s3control.New(sess), nil
+}
+
+func putMultiRegionAccessPointPolicy(conn *s3control.S3Control, accountID string, details *s3control.PutMultiRegionAccessPointPolicyInput_, timeout time.Duration) error {
+	input := &s3control.PutMultiRegionAccessPointPolicyInput{
+		AccountId:   aws.String(accountID),
+		ClientToken: aws.String(resource.UniqueId()),
+		Details:     details,
+	}
+
+	log.Printf("[DEBUG] Putting S3 Multi-Region Access Point Policy: %s", input)
+	output, err := conn.PutMultiRegionAccessPointPolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchMultiRegionAccessPoint) {
+		return &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if output == nil || output.RequestTokenARN == nil {
+		return fmt.Errorf("empty response")
+	}
+
+	if _, err := waitMultiRegionAccessPointRequestSucceeded(conn, accountID, aws.StringValue(output.RequestTokenARN), timeout); err != nil {
+		return fmt.Errorf("error waiting for request (%s) to succeed: %w", aws.StringValue(output.RequestTokenARN), err)
+	}
+
+	return nil
+}
+
+func multiRegionAccessPointDenyAllPolicy(accessPointARN string) string {
+	return fmt.Sprintf(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":[%[1]q,"%[1]s/object/*"]}]}`, accessPointARN)
+}
+
+func expandMultiRegionAccessPointPolicyDetails(tfMap map[string]interface{}) *s3control.PutMultiRegionAccessPointPolicyInput_ {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.PutMultiRegionAccessPointPolicyInput_{}
+
+	if v, ok := tfMap["name"].(string); ok {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["policy"].(string); ok {
+		apiObject.Policy = aws.String(v)
+	}
+
+	return apiObject
+}
+
+const multiRegionAccessPointResourceIDSeparator = ":"
+
+func MultiRegionAccessPointCreateResourceID(accountID, name string) string {
+	parts := []string{accountID, name}
+	id := strings.Join(parts, multiRegionAccessPointResourceIDSeparator)
+
+	return id
+}
+
+func MultiRegionAccessPointParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, multiRegionAccessPointResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ACCOUNT_ID%[2]sNAME", id, multiRegionAccessPointResourceIDSeparator)
+}
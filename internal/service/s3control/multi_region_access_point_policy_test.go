@@ -0,0 +1,190 @@
+package s3control_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	awspolicy "github.com/jen20/awspolicyequivalence"
+)
+
+func TestAccS3ControlMultiRegionAccessPointPolicy_basic(t *testing.T) {
+	var v s3control.MultiRegionAccessPointPolicyDocument
+	resourceName := "aws_s3control_multi_region_access_point_policy.test"
+	name, alias := testAccMultiRegionAccessPointFromEnv(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartition(endpoints.AwsPartitionID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMultiRegionAccessPointPolicyDestroy(alias),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiRegionAccessPointPolicyConfig(name, alias, "s3:GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiRegionAccessPointPolicyExists(resourceName, &v),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "details.0.name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "details.0.policy"),
+					resource.TestCheckResourceAttrSet(resourceName, "proposed"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The established policy may change while the proposed policy propagates.
+				ImportStateVerifyIgnore: []string{"established"},
+			},
+			{
+				Config: testAccMultiRegionAccessPointPolicyConfig(name, alias, "s3:PutObject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiRegionAccessPointPolicyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "details.0.name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "proposed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMultiRegionAccessPointFromEnv(t *testing.T) (string, string) {
+	nameKey := "AWS_S3CONTROL_MULTI_REGION_ACCESS_POINT_NAME"
+	aliasKey := "AWS_S3CONTROL_MULTI_REGION_ACCESS_POINT_ALIAS"
+
+	name := os.Getenv(nameKey)
+	if name == "" {
+		t.Skipf("Environment variable %s is not set", nameKey)
+	}
+
+	alias := os.Getenv(aliasKey)
+	if alias == "" {
+		t.Skipf("Environment variable %s is not set", aliasKey)
+	}
+
+	return name, alias
+}
+
+// testAccCheckMultiRegionAccessPointPolicyDestroy verifies that the policy denies all access,
+// as Multi-Region Access Point policies cannot be deleted.
+func testAccCheckMultiRegionAccessPointPolicyDestroy(alias string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := tfs3control.ConnForMRAP(acctest.Provider.Meta().(*conns.AWSClient))
+
+		if err != nil {
+			return err
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3control_multi_region_access_point_policy" {
+				continue
+			}
+
+			accountID, name, err := tfs3control.MultiRegionAccessPointParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			output, err := tfs3control.FindMultiRegionAccessPointPolicyDocumentByAccountIDAndName(conn, accountID, name)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if output.Proposed == nil {
+				continue
+			}
+
+			accessPointARN := fmt.Sprintf("arn:%s:s3::%s:accesspoint/%s", acctest.Partition(), accountID, alias)
+			expected := fmt.Sprintf(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":[%[1]q,"%[1]s/object/*"]}]}`, accessPointARN)
+
+			equivalent, err := awspolicy.PoliciesAreEquivalent(aws.StringValue(output.Proposed.Policy), expected)
+
+			if err != nil {
+				return fmt.Errorf("error testing policy equivalence: %w", err)
+			}
+
+			if !equivalent {
+				return fmt.Errorf("S3 Multi-Region Access Point Policy %s still allows access: %s", rs.Primary.ID, aws.StringValue(output.Proposed.Policy))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMultiRegionAccessPointPolicyExists(n string, v *s3control.MultiRegionAccessPointPolicyDocument) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Multi-Region Access Point Policy ID is set")
+		}
+
+		accountID, name, err := tfs3control.MultiRegionAccessPointParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn, err := tfs3control.ConnForMRAP(acctest.Provider.Meta().(*conns.AWSClient))
+
+		if err != nil {
+			return err
+		}
+
+		output, err := tfs3control.FindMultiRegionAccessPointPolicyDocumentByAccountIDAndName(conn, accountID, name)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccMultiRegionAccessPointPolicyConfig(name, alias, action string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_s3control_multi_region_access_point_policy" "test" {
+  details {
+    name = %[1]q
+
+    policy = jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Effect = "Allow"
+        Principal = {
+          AWS = data.aws_caller_identity.current.account_id
+        }
+        Action   = [%[3]q]
+        Resource = "arn:${data.aws_partition.current.partition}:s3::${data.aws_caller_identity.current.account_id}:accesspoint/%[2]s/object/*"
+      }]
+    })
+  }
+}
+`, name, alias, action)
+}
@@ -6,8 +6,38 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3control"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+const (
+	accessPointPublicAccessBlockConfigurationStatusAvailable = "AVAILABLE"
+	accessPointPublicAccessBlockConfigurationStatusPending   = "PENDING"
+
+	// RequestStatus values of asynchronous Multi-Region Access Point operations
+	multiRegionAccessPointRequestStatusFailed     = "FAILED"
+	multiRegionAccessPointRequestStatusInProgress = "IN_PROGRESS"
+	multiRegionAccessPointRequestStatusNew        = "NEW"
+	multiRegionAccessPointRequestStatusSucceeded  = "SUCCEEDED"
+)
+
+// statusAccessPointPublicAccessBlockConfiguration fetches the Access Point and reports whether its
+// PublicAccessBlockConfiguration is present
+func statusAccessPointPublicAccessBlockConfiguration(conn *s3control.S3Control, accountID, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAccessPointByAccountIDAndName(conn, accountID, name)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.PublicAccessBlockConfiguration == nil {
+			return output, accessPointPublicAccessBlockConfigurationStatusPending, nil
+		}
+
+		return output, accessPointPublicAccessBlockConfigurationStatusAvailable, nil
+	}
+}
+
 // statusPublicAccessBlockConfigurationBlockPublicACLs fetches the PublicAccessBlockConfiguration and its BlockPublicAcls
 func statusPublicAccessBlockConfigurationBlockPublicACLs(conn *s3control.S3Control, accountID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -75,3 +105,20 @@ func statusPublicAccessBlockConfigurationRestrictPublicBuckets(conn *s3control.S
 		return publicAccessBlockConfiguration, strconv.FormatBool(aws.BoolValue(publicAccessBlockConfiguration.RestrictPublicBuckets)), nil
 	}
 }
+
+// statusMultiRegionAccessPointRequest fetches the asynchronous Multi-Region Access Point operation and its RequestStatus
+func statusMultiRegionAccessPointRequest(conn *s3control.S3Control, accountID string, requestTokenARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindMultiRegionAccessPointOperationByAccountIDAndTokenARN(conn, accountID, requestTokenARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.RequestStatus), nil
+	}
+}
@@ -6,8 +6,10 @@ package s3control
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/outposts"
 	"github.com/aws/aws-sdk-go/service/s3control"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-multierror"
@@ -43,24 +45,16 @@ func sweepAccessPoints(region string) error {
 		}
 
 		for _, accessPoint := range page.AccessPointList {
-			input := &s3control.DeleteAccessPointInput{
-				AccountId: aws.String(accountId),
-				Name:      accessPoint.Name,
-			}
 			name := aws.StringValue(accessPoint.Name)
 
-			log.Printf("[INFO] Deleting S3 Access Point: %s", name)
-			_, err := conn.DeleteAccessPoint(input)
-
-			if tfawserr.ErrMessageContains(err, "NoSuchAccessPoint", "") {
+			if !strings.HasPrefix(name, sweepAccessPointNamePrefix) {
+				log.Printf("[INFO] Skipping S3 Access Point: %s", name)
 				continue
 			}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting S3 Access Point (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
+			if err := sweepAccessPoint(conn, fmt.Sprintf("%s:%s", accountId, name)); err != nil {
+				log.Printf("[ERROR] %s", err)
+				sweeperErrs = multierror.Append(sweeperErrs, err)
 			}
 		}
 
@@ -69,12 +63,130 @@ func sweepAccessPoints(region string) error {
 
 	if sweep.SkipSweepError(err) {
 		log.Printf("[WARN] Skipping S3 Access Point sweep for %s: %s", region, err)
-		return nil
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing S3 Access Points: %w", err)
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing S3 Access Points: %w", err))
+	}
+
+	if err := sweepOutpostsAccessPoints(client.(*conns.AWSClient)); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, err)
 	}
 
 	return sweeperErrs.ErrorOrNil()
 }
+
+// sweepOutpostsAccessPoints deletes the access points of the S3 on Outposts buckets in each Outpost.
+// Outposts access points are only listed when the bucket ARN is specified.
+func sweepOutpostsAccessPoints(client *conns.AWSClient) error {
+	accountId := client.AccountID
+	conn := client.S3ControlConn
+	var sweeperErrs *multierror.Error
+
+	err := client.OutpostsConn.ListOutpostsPages(&outposts.ListOutpostsInput{}, func(page *outposts.ListOutpostsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, outpost := range page.Outposts {
+			input := &s3control.ListRegionalBucketsInput{
+				AccountId: aws.String(accountId),
+				OutpostId: outpost.OutpostId,
+			}
+
+			err := conn.ListRegionalBucketsPages(input, func(page *s3control.ListRegionalBucketsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, bucket := range page.RegionalBucketList {
+					input := &s3control.ListAccessPointsInput{
+						AccountId: aws.String(accountId),
+						Bucket:    bucket.BucketArn,
+					}
+
+					err := conn.ListAccessPointsPages(input, func(page *s3control.ListAccessPointsOutput, lastPage bool) bool {
+						if page == nil {
+							return !lastPage
+						}
+
+						for _, accessPoint := range page.AccessPointList {
+							if !strings.HasPrefix(aws.StringValue(accessPoint.Name), sweepAccessPointNamePrefix) {
+								log.Printf("[INFO] Skipping S3 Access Point: %s", aws.StringValue(accessPoint.AccessPointArn))
+								continue
+							}
+
+							if err := sweepAccessPoint(conn, aws.StringValue(accessPoint.AccessPointArn)); err != nil {
+								log.Printf("[ERROR] %s", err)
+								sweeperErrs = multierror.Append(sweeperErrs, err)
+							}
+						}
+
+						return !lastPage
+					})
+
+					if err != nil {
+						sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing S3 Access Points for S3 on Outposts Bucket (%s): %w", aws.StringValue(bucket.BucketArn), err))
+					}
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing S3 on Outposts Buckets for Outpost (%s): %w", aws.StringValue(outpost.OutpostId), err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping S3 on Outposts Access Point sweep: %s", err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Outposts: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+// sweepAccessPoint removes the policy of the access point with the specified resource ID, then deletes the access point.
+func sweepAccessPoint(conn *s3control.S3Control, id string) error {
+	accountId, name, err := AccessPointParseID(id)
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting S3 Access Point Policy: %s", id)
+	_, err = conn.DeleteAccessPointPolicy(&s3control.DeleteAccessPointPolicyInput{
+		AccountId: aws.String(accountId),
+		Name:      aws.String(name),
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, errCodeNoSuchAccessPoint, errCodeNoSuchAccessPointPolicy) {
+		return fmt.Errorf("error deleting S3 Access Point (%s) policy: %w", id, err)
+	}
+
+	log.Printf("[INFO] Deleting S3 Access Point: %s", id)
+	_, err = conn.DeleteAccessPoint(&s3control.DeleteAccessPointInput{
+		AccountId: aws.String(accountId),
+		Name:      aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchAccessPoint) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Access Point (%s): %w", id, err)
+	}
+
+	return nil
+}
+
+const sweepAccessPointNamePrefix = "tf-acc-test"
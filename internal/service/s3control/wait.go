@@ -1,14 +1,20 @@
 package s3control
 
 import (
+	"fmt"
 	"strconv"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3control"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
+	// Maximum amount of time to wait for a newly created Access Point to become readable
+	accessPointCreatedPropagationTimeout = 15 * time.Second
+
 	// Minimum amount of times to verify change propagation
 	propagationContinuousTargetOccurence = 2
 
@@ -17,8 +23,30 @@ const (
 
 	// Maximum amount of time to wait for S3control changes to propagate
 	propagationTimeout = 1 * time.Minute
+
+	// Minimum amount of time to wait between asynchronous Multi-Region Access Point operation polls
+	multiRegionAccessPointRequestMinTimeout = 5 * time.Second
 )
 
+// waitAccessPointPublicAccessBlockConfigurationAvailable waits for a newly created Access Point to be returned
+// with its PublicAccessBlockConfiguration
+func waitAccessPointPublicAccessBlockConfigurationAvailable(conn *s3control.S3Control, accountID, name string) (*s3control.GetAccessPointOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{accessPointPublicAccessBlockConfigurationStatusPending},
+		Target:  []string{accessPointPublicAccessBlockConfigurationStatusAvailable},
+		Refresh: statusAccessPointPublicAccessBlockConfiguration(conn, accountID, name),
+		Timeout: accessPointCreatedPropagationTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*s3control.GetAccessPointOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitPublicAccessBlockConfigurationBlockPublicACLsUpdated(conn *s3control.S3Control, accountID string, expectedValue bool) (*s3control.PublicAccessBlockConfiguration, error) {
 	stateConf := &resource.StateChangeConf{
 		Target:                    []string{strconv.FormatBool(expectedValue)},
@@ -90,3 +118,27 @@ func waitPublicAccessBlockConfigurationRestrictPublicBucketsUpdated(conn *s3cont
 
 	return nil, err
 }
+
+// waitMultiRegionAccessPointRequestSucceeded waits for an asynchronous Multi-Region Access Point operation to succeed
+func waitMultiRegionAccessPointRequestSucceeded(conn *s3control.S3Control, accountID string, requestTokenARN string, timeout time.Duration) (*s3control.AsyncOperation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{multiRegionAccessPointRequestStatusNew, multiRegionAccessPointRequestStatusInProgress},
+		Target:     []string{multiRegionAccessPointRequestStatusSucceeded},
+		Refresh:    statusMultiRegionAccessPointRequest(conn, accountID, requestTokenARN),
+		Timeout:    timeout,
+		MinTimeout: multiRegionAccessPointRequestMinTimeout,
+		Delay:      multiRegionAccessPointRequestMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*s3control.AsyncOperation); ok {
+		if status, responseDetails := aws.StringValue(output.RequestStatus), output.ResponseDetails; status == multiRegionAccessPointRequestStatusFailed && responseDetails != nil && responseDetails.ErrorDetails != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(responseDetails.ErrorDetails.Code), aws.StringValue(responseDetails.ErrorDetails.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
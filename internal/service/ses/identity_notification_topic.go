@@ -1,18 +1,28 @@
 package ses
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	// Maximum amount of time to wait for a newly created SNS topic to become visible to SES
+	identityNotificationTopicPropagationTimeout = 2 * time.Minute
+)
+
 func ResourceIdentityNotificationTopic() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNotificationTopicSet,
@@ -20,7 +30,7 @@ func ResourceIdentityNotificationTopic() *schema.Resource {
 		Update: resourceNotificationTopicSet,
 		Delete: resourceIdentityNotificationTopicDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceIdentityNotificationTopicImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -45,7 +55,7 @@ func ResourceIdentityNotificationTopic() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.NoZeroValues,
+				ValidateFunc: validIdentity,
 			},
 
 			"include_original_headers": {
@@ -53,7 +63,35 @@ func ResourceIdentityNotificationTopic() *schema.Resource {
 				Optional: true,
 			},
 		},
+
+		CustomizeDiff: resourceIdentityNotificationTopicCustomizeDiff,
+	}
+}
+
+// resourceIdentityNotificationTopicCustomizeDiff checks that the SNS topic is in the same region as
+// the SES identity, as SES rejects notification topics in other regions.
+func resourceIdentityNotificationTopicCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("topic_arn") {
+		return nil
+	}
+
+	topicARN := diff.Get("topic_arn").(string)
+
+	if topicARN == "" {
+		return nil
+	}
+
+	parsedARN, err := arn.Parse(topicARN)
+
+	if err != nil {
+		return fmt.Errorf("error parsing topic_arn (%s): %w", topicARN, err)
+	}
+
+	if region := meta.(*conns.AWSClient).Region; parsedARN.Region != region {
+		return fmt.Errorf("topic_arn (%s) must be in the same region (%s) as the SES identity, got: %s", topicARN, region, parsedARN.Region)
 	}
+
+	return nil
 }
 
 func resourceNotificationTopicSet(d *schema.ResourceData, meta interface{}) error {
@@ -75,7 +113,22 @@ func resourceNotificationTopicSet(d *schema.ResourceData, meta interface{}) erro
 
 	log.Printf("[DEBUG] Setting SES Identity Notification Topic: %#v", setOpts)
 
-	if _, err := conn.SetIdentityNotificationTopic(setOpts); err != nil {
+	// SES briefly rejects SNS topics created in the same apply as not existing.
+	_, err := tfresource.RetryWhen(
+		identityNotificationTopicPropagationTimeout,
+		func() (interface{}, error) {
+			return conn.SetIdentityNotificationTopic(setOpts)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "Invalid TopicArn") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
 		return fmt.Errorf("Error setting SES Identity Notification Topic: %s", err)
 	}
 
@@ -142,6 +195,41 @@ func resourceIdentityNotificationTopicRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
+func resourceIdentityNotificationTopicImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).SESConn
+
+	identity, notificationType, err := decodeSesIdentityNotificationTopicId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, errs := validIdentity(identity, "identity"); len(errs) > 0 {
+		return nil, fmt.Errorf("unexpected format of ID (%q), invalid identity: %w", d.Id(), errs[0])
+	}
+
+	if _, errs := validation.StringInSlice(ses.NotificationType_Values(), false)(notificationType, "notification_type"); len(errs) > 0 {
+		return nil, fmt.Errorf("unexpected format of ID (%q), invalid notification type: %w", d.Id(), errs[0])
+	}
+
+	response, err := conn.GetIdentityNotificationAttributes(&ses.GetIdentityNotificationAttributesInput{
+		Identities: []*string{aws.String(identity)},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading SES Identity Notification Topic (%s): %w", d.Id(), err)
+	}
+
+	if response == nil || response.NotificationAttributes[identity] == nil {
+		return nil, fmt.Errorf("error importing SES Identity Notification Topic (%s): identity %q not found", d.Id(), identity)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", identity, notificationType))
+	d.Set("identity", identity)
+	d.Set("notification_type", notificationType)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIdentityNotificationTopicDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SESConn
 
@@ -162,12 +250,25 @@ func resourceIdentityNotificationTopicDelete(d *schema.ResourceData, meta interf
 		return fmt.Errorf("Error deleting SES Identity Notification Topic: %s", err)
 	}
 
+	// Reset header forwarding too so that nothing is left configured for this notification type.
+	setHeadersOpts := &ses.SetIdentityHeadersInNotificationsEnabledInput{
+		Identity:         aws.String(identity),
+		NotificationType: aws.String(notificationType),
+		Enabled:          aws.Bool(false),
+	}
+
+	log.Printf("[DEBUG] Disabling SES Identity Notification Topic Headers: %#v", setHeadersOpts)
+
+	if _, err := conn.SetIdentityHeadersInNotificationsEnabled(setHeadersOpts); err != nil {
+		return fmt.Errorf("Error disabling SES Identity Notification Topic Headers Forwarding: %s", err)
+	}
+
 	return resourceIdentityNotificationTopicRead(d, meta)
 }
 
 func decodeSesIdentityNotificationTopicId(id string) (string, string, error) {
 	parts := strings.Split(id, "|")
-	if len(parts) != 2 {
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected IDENTITY|TYPE", id)
 	}
 	return parts[0], parts[1], nil
@@ -1,20 +1,133 @@
 package ses_test
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strconv"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ses"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/stub"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfses "github.com/hashicorp/terraform-provider-aws/internal/service/ses"
 )
 
+func TestIdentityNotificationTopicCreate_topicNotYetVisible(t *testing.T) {
+	topicARN := "arn:aws:sns:us-west-2:123456789012:example" //lintignore:AWSAT003,AWSAT005
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"identity":          "example.com",
+		"notification_type": ses.NotificationTypeBounce,
+		"topic_arn":         topicARN,
+	})
+
+	var setTopicCalls int
+
+	conn := ses.New(stub.Session(t))
+	stub.Send(conn.Client, func(r *request.Request) {
+		switch output := r.Data.(type) {
+		case *ses.SetIdentityNotificationTopicOutput:
+			setTopicCalls++
+
+			if setTopicCalls == 1 {
+				r.Error = awserr.New("InvalidParameterValue", fmt.Sprintf("Invalid TopicArn: %s", topicARN), nil)
+			}
+		case *ses.GetIdentityNotificationAttributesOutput:
+			output.NotificationAttributes = map[string]*ses.IdentityNotificationAttributes{
+				"example.com": {
+					BounceTopic:                         aws.String(topicARN),
+					HeadersInBounceNotificationsEnabled: aws.Bool(false),
+				},
+			}
+		}
+	})
+
+	meta := &conns.AWSClient{Region: "us-west-2", SESConn: conn} //lintignore:AWSAT003
+	r := tfses.ResourceIdentityNotificationTopic()
+
+	diff, err := r.Diff(context.Background(), nil, config, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	state, diags := r.Apply(context.Background(), nil, diff, meta)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, expected := setTopicCalls, 2; got != expected {
+		t.Errorf("got %d SetIdentityNotificationTopic calls, expected %d", got, expected)
+	}
+
+	if got, expected := state.Attributes["topic_arn"], topicARN; got != expected {
+		t.Errorf("got topic_arn %s, expected %s", got, expected)
+	}
+}
+
+func TestIdentityNotificationTopicCustomizeDiff_topicRegion(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		TopicARN      string
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			Name:     "same region",
+			TopicARN: "arn:aws:sns:us-west-2:123456789012:example", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name: "no topic",
+		},
+		{
+			Name:          "cross region",
+			TopicARN:      "arn:aws:sns:us-east-1:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			ExpectedError: regexp.MustCompile(`topic_arn \(arn:aws:sns:us-east-1:123456789012:example\) must be in the same region \(us-west-2\) as the SES identity, got: us-east-1`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"identity":          "example.com",
+				"notification_type": ses.NotificationTypeBounce,
+			}
+
+			if testCase.TopicARN != "" {
+				raw["topic_arn"] = testCase.TopicARN
+			}
+
+			meta := &conns.AWSClient{Region: "us-west-2"} //lintignore:AWSAT003
+
+			_, err := tfses.ResourceIdentityNotificationTopic().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), meta)
+
+			if testCase.ExpectedError != nil {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				if !testCase.ExpectedError.MatchString(err.Error()) {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestAccSESIdentityNotificationTopic_basic(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	topicName := sdkacctest.RandomWithPrefix("test-topic")
@@ -52,6 +165,121 @@ func TestAccSESIdentityNotificationTopic_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: fmt.Sprintf(testAccIdentityNotificationTopicConfig_bounce, domain, topicName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityNotificationTopicExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification_type", ses.NotificationTypeBounce),
+					testAccCheckIdentityNotificationTopicTypeCleared(resourceName, ses.NotificationTypeComplaint),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSESIdentityNotificationTopic_crossRegionTopic(t *testing.T) {
+	domain := acctest.RandomDomainName()
+	topicName := sdkacctest.RandomWithPrefix("test-topic")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ses.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckIdentityNotificationTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testAccIdentityNotificationTopicConfig_crossRegionTopic, domain, acctest.AlternateRegion(), topicName),
+				ExpectError: regexp.MustCompile(`must be in the same region \(` + acctest.Region() + `\) as the SES identity`),
+			},
+		},
+	})
+}
+
+func TestAccSESIdentityNotificationTopic_emailAddress(t *testing.T) {
+	email := acctest.DefaultEmailAddress
+	topicName := sdkacctest.RandomWithPrefix("test-topic")
+	resourceName := "aws_ses_identity_notification_topic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ses.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckIdentityNotificationTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIdentityNotificationTopicConfig_emailAddress, email, topicName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityNotificationTopicExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity", email),
+					resource.TestCheckResourceAttrPair(resourceName, "topic_arn", "aws_sns_topic.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSESIdentityNotificationTopic_importNotificationTypes(t *testing.T) {
+	domain := acctest.RandomDomainName()
+	topicName := sdkacctest.RandomWithPrefix("test-topic")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ses.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckIdentityNotificationTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIdentityNotificationTopicConfig_notificationTypes, domain, topicName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityNotificationTopicExists("aws_ses_identity_notification_topic.bounce"),
+					testAccCheckIdentityNotificationTopicExists("aws_ses_identity_notification_topic.complaint"),
+					testAccCheckIdentityNotificationTopicExists("aws_ses_identity_notification_topic.delivery"),
+				),
+			},
+			{
+				ResourceName:      "aws_ses_identity_notification_topic.bounce",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s|%s", domain, ses.NotificationTypeBounce),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "aws_ses_identity_notification_topic.complaint",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s|%s", domain, ses.NotificationTypeComplaint),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "aws_ses_identity_notification_topic.delivery",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s|%s", domain, ses.NotificationTypeDelivery),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "aws_ses_identity_notification_topic.bounce",
+				ImportState:   true,
+				ImportStateId: domain,
+				ExpectError:   regexp.MustCompile(`expected IDENTITY\|TYPE`),
+			},
+			{
+				ResourceName:  "aws_ses_identity_notification_topic.bounce",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s|Open", domain),
+				ExpectError:   regexp.MustCompile(`invalid notification type`),
+			},
 		},
 	})
 }
@@ -134,6 +362,56 @@ func testAccCheckIdentityNotificationTopicExists(n string) resource.TestCheckFun
 	}
 }
 
+// testAccCheckIdentityNotificationTopicTypeCleared verifies that no topic or header forwarding
+// remains configured for the specified notification type of the resource's identity.
+func testAccCheckIdentityNotificationTopicTypeCleared(n, notificationType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("SES Identity Notification Topic not found: %s", n)
+		}
+
+		identity := rs.Primary.Attributes["identity"]
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESConn
+
+		response, err := conn.GetIdentityNotificationAttributes(&ses.GetIdentityNotificationAttributesInput{
+			Identities: []*string{aws.String(identity)},
+		})
+		if err != nil {
+			return err
+		}
+
+		attributes := response.NotificationAttributes[identity]
+		if attributes == nil {
+			return fmt.Errorf("SES Identity %s not found in AWS", identity)
+		}
+
+		var topic string
+		var headersIncluded bool
+		switch notificationType {
+		case ses.NotificationTypeBounce:
+			topic = aws.StringValue(attributes.BounceTopic)
+			headersIncluded = aws.BoolValue(attributes.HeadersInBounceNotificationsEnabled)
+		case ses.NotificationTypeComplaint:
+			topic = aws.StringValue(attributes.ComplaintTopic)
+			headersIncluded = aws.BoolValue(attributes.HeadersInComplaintNotificationsEnabled)
+		case ses.NotificationTypeDelivery:
+			topic = aws.StringValue(attributes.DeliveryTopic)
+			headersIncluded = aws.BoolValue(attributes.HeadersInDeliveryNotificationsEnabled)
+		}
+
+		if topic != "" {
+			return fmt.Errorf("SES Identity %s still has %s notification topic %s", identity, notificationType, topic)
+		}
+
+		if headersIncluded {
+			return fmt.Errorf("SES Identity %s still includes original headers in %s notifications", identity, notificationType)
+		}
+
+		return nil
+	}
+}
+
 const testAccIdentityNotificationTopicConfig_basic = `
 resource "aws_ses_identity_notification_topic" "test" {
   identity          = aws_ses_domain_identity.test.arn
@@ -161,6 +439,39 @@ resource "aws_sns_topic" "test" {
 }
 `
 
+const testAccIdentityNotificationTopicConfig_crossRegionTopic = `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_ses_identity_notification_topic" "test" {
+  topic_arn         = "arn:${data.aws_partition.current.partition}:sns:%[2]s:${data.aws_caller_identity.current.account_id}:%[3]s"
+  identity          = aws_ses_domain_identity.test.arn
+  notification_type = "Complaint"
+}
+
+resource "aws_ses_domain_identity" "test" {
+  domain = "%[1]s"
+}
+`
+
+const testAccIdentityNotificationTopicConfig_bounce = `
+resource "aws_ses_identity_notification_topic" "test" {
+  topic_arn                = aws_sns_topic.test.arn
+  identity                 = aws_ses_domain_identity.test.arn
+  notification_type        = "Bounce"
+  include_original_headers = true
+}
+
+resource "aws_ses_domain_identity" "test" {
+  domain = "%s"
+}
+
+resource "aws_sns_topic" "test" {
+  name = "%s"
+}
+`
+
 const testAccIdentityNotificationTopicConfig_headers = `
 resource "aws_ses_identity_notification_topic" "test" {
   topic_arn                = aws_sns_topic.test.arn
@@ -177,3 +488,49 @@ resource "aws_sns_topic" "test" {
   name = "%s"
 }
 `
+
+const testAccIdentityNotificationTopicConfig_emailAddress = `
+resource "aws_ses_identity_notification_topic" "test" {
+  topic_arn         = aws_sns_topic.test.arn
+  identity          = aws_ses_email_identity.test.email
+  notification_type = "Bounce"
+}
+
+resource "aws_ses_email_identity" "test" {
+  email = %q
+}
+
+resource "aws_sns_topic" "test" {
+  name = %q
+}
+`
+
+const testAccIdentityNotificationTopicConfig_notificationTypes = `
+resource "aws_ses_domain_identity" "test" {
+  domain = %[1]q
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[2]q
+}
+
+resource "aws_ses_identity_notification_topic" "bounce" {
+  topic_arn                = aws_sns_topic.test.arn
+  identity                 = aws_ses_domain_identity.test.domain
+  notification_type        = "Bounce"
+  include_original_headers = true
+}
+
+resource "aws_ses_identity_notification_topic" "complaint" {
+  topic_arn         = aws_sns_topic.test.arn
+  identity          = aws_ses_domain_identity.test.domain
+  notification_type = "Complaint"
+}
+
+resource "aws_ses_identity_notification_topic" "delivery" {
+  topic_arn                = aws_sns_topic.test.arn
+  identity                 = aws_ses_domain_identity.test.domain
+  notification_type        = "Delivery"
+  include_original_headers = true
+}
+`
@@ -42,7 +42,7 @@ func ResourceIdentityPolicy() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     validation.StringIsJSON,
+				ValidateFunc:     validIdentityPolicy,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
 			},
 		},
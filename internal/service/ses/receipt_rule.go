@@ -2,6 +2,7 @@ package ses
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -30,6 +31,8 @@ func ResourceReceiptRule() *schema.Resource {
 			State: resourceReceiptRuleImport,
 		},
 
+		CustomizeDiff: resourceReceiptRuleCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -397,6 +400,54 @@ func ResourceReceiptRule() *schema.Resource {
 	}
 }
 
+// receiptRuleActionTypes are the receipt rule action blocks, each of which is positioned in the rule's ordered list of actions.
+var receiptRuleActionTypes = []string{
+	"add_header_action",
+	"bounce_action",
+	"lambda_action",
+	"s3_action",
+	"sns_action",
+	"stop_action",
+	"workmail_action",
+}
+
+func resourceReceiptRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	positions := make(map[int]string)
+	maxPosition := 0
+
+	for _, k := range receiptRuleActionTypes {
+		if !diff.NewValueKnown(k) {
+			return nil
+		}
+
+		for _, v := range diff.Get(k).(*schema.Set).List() {
+			position := v.(map[string]interface{})["position"].(int)
+
+			if other, ok := positions[position]; ok {
+				return fmt.Errorf("%s and %s cannot both have position %d", other, k, position)
+			}
+
+			positions[position] = k
+
+			if position > maxPosition {
+				maxPosition = position
+			}
+		}
+	}
+
+	if v := diff.Get("stop_action").(*schema.Set).List(); len(v) > 0 {
+		if len(v) > 1 {
+			return fmt.Errorf("at most one stop_action can be specified, got %d", len(v))
+		}
+
+		if position := v[0].(map[string]interface{})["position"].(int); position != maxPosition {
+			return fmt.Errorf("stop_action (position %d) must be the last action (position %d)", position, maxPosition)
+		}
+	}
+
+	return nil
+}
+
 func resourceReceiptRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	idParts := strings.Split(d.Id(), ":")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
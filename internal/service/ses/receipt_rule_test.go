@@ -1,7 +1,9 @@
 package ses_test
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -309,6 +311,11 @@ func TestAccSESReceiptRule_actions(t *testing.T) {
 						"header_value": "First",
 						"position":     "1",
 					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "stop_action.*", map[string]string{
+						"scope":    "RuleSet",
+						"position": "3",
+					}),
+					testAccCheckReceiptRuleActionHeaders(&rule, "Another-Header", "Added-By", ""),
 				),
 			},
 			{
@@ -320,6 +327,105 @@ func TestAccSESReceiptRule_actions(t *testing.T) {
 	})
 }
 
+func TestReceiptRuleCustomizeDiff_actions(t *testing.T) {
+	addHeaderAction := func(name string, position int) map[string]interface{} {
+		return map[string]interface{}{
+			"header_name":  name,
+			"header_value": "test",
+			"position":     position,
+		}
+	}
+	stopAction := func(position int) map[string]interface{} {
+		return map[string]interface{}{
+			"scope":    ses.StopScopeRuleSet,
+			"position": position,
+		}
+	}
+
+	testCases := []struct {
+		Name            string
+		AddHeaderAction []interface{}
+		SNSAction       []interface{}
+		StopAction      []interface{}
+		ExpectedError   *regexp.Regexp
+	}{
+		{
+			Name:            "multiple actions",
+			AddHeaderAction: []interface{}{addHeaderAction("First", 2), addHeaderAction("Second", 1)},
+			SNSAction: []interface{}{map[string]interface{}{
+				"topic_arn": "arn:aws:sns:us-west-2:123456789012:test", //lintignore:AWSAT003,AWSAT005
+				"position":  3,
+			}},
+		},
+		{
+			Name:            "multiple actions with stop_action last",
+			AddHeaderAction: []interface{}{addHeaderAction("First", 1), addHeaderAction("Second", 2)},
+			StopAction:      []interface{}{stopAction(3)},
+		},
+		{
+			Name:       "stop_action only",
+			StopAction: []interface{}{stopAction(1)},
+		},
+		{
+			Name:            "stop_action not last",
+			AddHeaderAction: []interface{}{addHeaderAction("First", 1), addHeaderAction("Second", 3)},
+			StopAction:      []interface{}{stopAction(2)},
+			ExpectedError:   regexp.MustCompile(`stop_action \(position 2\) must be the last action \(position 3\)`),
+		},
+		{
+			Name:            "multiple stop_action",
+			AddHeaderAction: []interface{}{addHeaderAction("First", 1)},
+			StopAction:      []interface{}{stopAction(2), stopAction(3)},
+			ExpectedError:   regexp.MustCompile(`at most one stop_action can be specified, got 2`),
+		},
+		{
+			Name:            "duplicate position",
+			AddHeaderAction: []interface{}{addHeaderAction("First", 1)},
+			StopAction:      []interface{}{stopAction(1)},
+			ExpectedError:   regexp.MustCompile(`add_header_action and stop_action cannot both have position 1`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"name":          "test",
+				"rule_set_name": "test",
+			}
+
+			if testCase.AddHeaderAction != nil {
+				raw["add_header_action"] = testCase.AddHeaderAction
+			}
+
+			if testCase.SNSAction != nil {
+				raw["sns_action"] = testCase.SNSAction
+			}
+
+			if testCase.StopAction != nil {
+				raw["stop_action"] = testCase.StopAction
+			}
+
+			_, err := tfses.ResourceReceiptRule().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), &conns.AWSClient{})
+
+			if testCase.ExpectedError != nil {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				if !testCase.ExpectedError.MatchString(err.Error()) {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestAccSESReceiptRule_disappears(t *testing.T) {
 	var rule ses.ReceiptRule
 
@@ -417,6 +523,29 @@ func testAccCheckReceiptRuleExists(n string, rule *ses.ReceiptRule) resource.Tes
 	}
 }
 
+// testAccCheckReceiptRuleActionHeaders checks the order of a receipt rule's actions.
+// Each expected value is the header name of an add header action, or "" for any other action.
+func testAccCheckReceiptRuleActionHeaders(rule *ses.ReceiptRule, expected ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := len(rule.Actions); got != len(expected) {
+			return fmt.Errorf("expected %d SES receipt rule actions, got %d", len(expected), got)
+		}
+
+		for i, action := range rule.Actions {
+			var got string
+			if action.AddHeaderAction != nil {
+				got = aws.StringValue(action.AddHeaderAction.HeaderName)
+			}
+
+			if got != expected[i] {
+				return fmt.Errorf("expected SES receipt rule action %d header %q, got %q", i+1, expected[i], got)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccReceiptRuleImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -6,6 +6,7 @@ package ses
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ses"
@@ -25,11 +26,22 @@ func init() {
 	resource.AddTestSweepers("aws_ses_domain_identity", &resource.Sweeper{
 		Name: "aws_ses_domain_identity",
 		F:    func(region string) error { return sweepIdentities(region, ses.IdentityTypeDomain) },
+		Dependencies: []string{
+			"aws_ses_identity_notification_topic",
+		},
 	})
 
 	resource.AddTestSweepers("aws_ses_email_identity", &resource.Sweeper{
 		Name: "aws_ses_email_identity",
 		F:    func(region string) error { return sweepIdentities(region, ses.IdentityTypeEmailAddress) },
+		Dependencies: []string{
+			"aws_ses_identity_notification_topic",
+		},
+	})
+
+	resource.AddTestSweepers("aws_ses_identity_notification_topic", &resource.Sweeper{
+		Name: "aws_ses_identity_notification_topic",
+		F:    sweepIdentityNotificationTopics,
 	})
 
 	resource.AddTestSweepers("aws_ses_receipt_rule_set", &resource.Sweeper{
@@ -104,6 +116,11 @@ func sweepIdentities(region, identityType string) error {
 		for _, identity := range page.Identities {
 			identity := aws.StringValue(identity)
 
+			if !sweepableIdentity(identity) {
+				log.Printf("[INFO] Skipping SES Identity: %s", identity)
+				continue
+			}
+
 			log.Printf("[INFO] Deleting SES Identity: %s", identity)
 			_, err = conn.DeleteIdentity(&ses.DeleteIdentityInput{
 				Identity: aws.String(identity),
@@ -129,6 +146,101 @@ func sweepIdentities(region, identityType string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func sweepIdentityNotificationTopics(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).SESConn
+	input := &ses.ListIdentitiesInput{}
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListIdentitiesPages(input, func(page *ses.ListIdentitiesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		var identities []*string
+
+		for _, identity := range page.Identities {
+			if sweepableIdentity(aws.StringValue(identity)) {
+				identities = append(identities, identity)
+			}
+		}
+
+		if len(identities) == 0 {
+			return !lastPage
+		}
+
+		output, err := conn.GetIdentityNotificationAttributes(&ses.GetIdentityNotificationAttributesInput{
+			Identities: identities,
+		})
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error reading SES Identity Notification Attributes: %w", err))
+			return !lastPage
+		}
+
+		for identity, attributes := range output.NotificationAttributes {
+			if attributes == nil {
+				continue
+			}
+
+			topics := map[string]*string{
+				ses.NotificationTypeBounce:    attributes.BounceTopic,
+				ses.NotificationTypeComplaint: attributes.ComplaintTopic,
+				ses.NotificationTypeDelivery:  attributes.DeliveryTopic,
+			}
+
+			for notificationType, topic := range topics {
+				if aws.StringValue(topic) == "" {
+					continue
+				}
+
+				id := fmt.Sprintf("%s|%s", identity, notificationType)
+
+				log.Printf("[INFO] Deleting SES Identity Notification Topic: %s", id)
+				_, err := conn.SetIdentityNotificationTopic(&ses.SetIdentityNotificationTopicInput{
+					Identity:         aws.String(identity),
+					NotificationType: aws.String(notificationType),
+				})
+				if err != nil {
+					sweeperErr := fmt.Errorf("error deleting SES Identity Notification Topic (%s): %w", id, err)
+					log.Printf("[ERROR] %s", sweeperErr)
+					sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+					continue
+				}
+			}
+		}
+
+		return !lastPage
+	})
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping SES Identity Notification Topics sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving SES Identities: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+// sweepableIdentity returns whether the specified SES identity was created by the acceptance tests.
+// Test domains use the reserved ".test" top level domain and test email addresses either belong
+// to such a domain or are named with the standard "tf-acc-test" resource prefix.
+func sweepableIdentity(identity string) bool {
+	if strings.HasPrefix(identity, "tf-acc-test") {
+		return true
+	}
+
+	domain := identity
+	if i := strings.LastIndex(identity, "@"); i >= 0 {
+		domain = identity[i+1:]
+	}
+
+	return strings.HasSuffix(strings.TrimSuffix(domain, "."), ".test")
+}
+
 func sweepReceiptRuleSets(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
@@ -0,0 +1,130 @@
+package ses
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// validIdentityPolicy validates that a sending authorization policy is a valid IAM policy document
+// that only grants SES actions. A warning is returned if the policy allows anyone to perform all SES actions.
+func validIdentityPolicy(v interface{}, k string) (ws []string, errors []error) {
+	ws, errors = verify.ValidIAMPolicyJSON(v, k)
+
+	if len(errors) > 0 {
+		return
+	}
+
+	var policy tfiam.IAMPolicyDoc
+
+	if err := json.Unmarshal([]byte(v.(string)), &policy); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON policy: %w", k, err))
+		return
+	}
+
+	for _, statement := range policy.Statements {
+		if statement == nil {
+			continue
+		}
+
+		actions, err := statement.ActionList()
+
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q contains an invalid policy statement: %w", k, err))
+			continue
+		}
+
+		allActions := false
+
+		for _, action := range actions {
+			switch action = strings.ToLower(action); {
+			case action == "*" || action == "ses:*":
+				allActions = true
+			case !strings.HasPrefix(action, "ses:"):
+				errors = append(errors, fmt.Errorf("%q contains a policy statement with non-SES action %q", k, action))
+			}
+		}
+
+		if allActions && strings.EqualFold(statement.Effect, "Allow") && statement.Principals.HasAnonymous() {
+			ws = append(ws, fmt.Sprintf("%q contains a policy statement that allows all SES actions (ses:*) to any principal (*)", k))
+		}
+	}
+
+	return
+}
+
+var identityDomainLabelRegexp = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// validIdentity validates that an SES identity is an email address, a domain name
+// or the ARN of an email address or domain identity.
+func validIdentity(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if arn.IsARN(value) {
+		parsedARN, err := arn.Parse(value)
+
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %w", k, value, err))
+			return
+		}
+
+		if parsedARN.Service != "ses" || !strings.HasPrefix(parsedARN.Resource, "identity/") {
+			errors = append(errors, fmt.Errorf("%q (%s) must be an SES identity ARN, e.g. arn:aws:ses:us-east-1:123456789012:identity/example.com", k, value))
+			return
+		}
+
+		if identity := strings.TrimPrefix(parsedARN.Resource, "identity/"); !isIdentityEmailAddress(identity) && !isIdentityDomain(identity) {
+			errors = append(errors, fmt.Errorf("%q (%s) must identify an email address or a domain name, got: %q", k, value, identity))
+		}
+
+		return
+	}
+
+	if strings.Contains(value, "@") {
+		if !isIdentityEmailAddress(value) {
+			errors = append(errors, fmt.Errorf("%q (%s) is not a valid email address, expected the form user@example.com", k, value))
+		}
+
+		return
+	}
+
+	if !isIdentityDomain(value) {
+		errors = append(errors, fmt.Errorf("%q (%s) must be an email address, a domain name or an SES identity ARN", k, value))
+	}
+
+	return
+}
+
+func isIdentityEmailAddress(s string) bool {
+	i := strings.LastIndex(s, "@")
+
+	if i < 1 || strings.ContainsAny(s, " \t\n") {
+		return false
+	}
+
+	return isIdentityDomain(s[i+1:])
+}
+
+// isIdentityDomain returns whether s is a domain name of at least two labels.
+// A trailing period is allowed.
+func isIdentityDomain(s string) bool {
+	labels := strings.Split(strings.TrimSuffix(s, "."), ".")
+
+	if len(labels) < 2 {
+		return false
+	}
+
+	for _, label := range labels {
+		if !identityDomainLabelRegexp.MatchString(label) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,168 @@
+package ses
+
+import (
+	"testing"
+)
+
+func TestValidIdentityPolicy(t *testing.T) {
+	cases := []struct {
+		Name         string
+		Value        string
+		ErrCount     int
+		WarningCount int
+	}{
+		{
+			Name:     "empty",
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Name:     "malformed JSON",
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow",}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "not a JSON object",
+			Value:    `["ses:SendEmail"]`,
+			ErrCount: 1,
+		},
+		{
+			Name:  "sending authorization",
+			Value: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":["ses:SendEmail","SES:SendRawEmail"],"Resource":"*"}]}`,
+		},
+		{
+			Name:  "single action",
+			Value: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["123456789012"]},"Action":"ses:SendTemplatedEmail","Resource":"*"}]}`,
+		},
+		{
+			Name:     "non-SES action",
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":["ses:SendEmail","s3:GetObject"],"Resource":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:         "all actions to anyone",
+			Value:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"ses:*","Resource":"*"}]}`,
+			WarningCount: 1,
+		},
+		{
+			Name:         "all actions to any AWS principal",
+			Value:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":["ses:*"],"Resource":"*"}]}`,
+			WarningCount: 1,
+		},
+		{
+			Name:  "all actions denied to anyone",
+			Value: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"ses:*","Resource":"*"}]}`,
+		},
+		{
+			Name:  "all actions to specific principal",
+			Value: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"ses:*","Resource":"*"}]}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ws, errors := validIdentityPolicy(tc.Value, "policy")
+
+			if got, want := len(errors), tc.ErrCount; got != want {
+				t.Errorf("got %d errors, expected %d: %v", got, want, errors)
+			}
+
+			if got, want := len(ws), tc.WarningCount; got != want {
+				t.Errorf("got %d warnings, expected %d: %v", got, want, ws)
+			}
+		})
+	}
+}
+
+func TestValidIdentity(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Value    string
+		ErrCount int
+	}{
+		{
+			Name:     "empty",
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Name:  "domain",
+			Value: "example.com",
+		},
+		{
+			Name:  "domain with trailing period",
+			Value: "mail.example.com.",
+		},
+		{
+			Name:     "single label domain",
+			Value:    "localhost",
+			ErrCount: 1,
+		},
+		{
+			Name:     "domain with invalid label",
+			Value:    "-example.com",
+			ErrCount: 1,
+		},
+		{
+			Name:     "domain with empty label",
+			Value:    "example..com",
+			ErrCount: 1,
+		},
+		{
+			Name:  "email address",
+			Value: "no-reply@example.com",
+		},
+		{
+			Name:  "email address with trailing period",
+			Value: "no-reply@example.com.",
+		},
+		{
+			Name:     "email address without local part",
+			Value:    "@example.com",
+			ErrCount: 1,
+		},
+		{
+			Name:     "email address without domain",
+			Value:    "no-reply@",
+			ErrCount: 1,
+		},
+		{
+			Name:     "email address with whitespace",
+			Value:    "no reply@example.com",
+			ErrCount: 1,
+		},
+		{
+			Name:  "domain identity ARN",
+			Value: "arn:aws:ses:us-west-2:123456789012:identity/example.com",
+		},
+		{
+			Name:  "email address identity ARN",
+			Value: "arn:aws:ses:us-west-2:123456789012:identity/no-reply@example.com",
+		},
+		{
+			Name:     "non-SES ARN",
+			Value:    "arn:aws:sns:us-west-2:123456789012:identity/example.com",
+			ErrCount: 1,
+		},
+		{
+			Name:     "non-identity SES ARN",
+			Value:    "arn:aws:ses:us-west-2:123456789012:configuration-set/example",
+			ErrCount: 1,
+		},
+		{
+			Name:     "identity ARN with malformed identity",
+			Value:    "arn:aws:ses:us-west-2:123456789012:identity/example",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, errors := validIdentity(tc.Value, "identity")
+
+			if len(errors) != tc.ErrCount {
+				t.Fatalf("expected %d errors, got %d: %v", tc.ErrCount, len(errors), errors)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package sesv2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceAccountSendingAttributes() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccountSendingAttributesPut,
+		Read:   resourceAccountSendingAttributesRead,
+		Update: resourceAccountSendingAttributesPut,
+		Delete: resourceAccountSendingAttributesDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dedicated_ip_auto_warmup_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"production_access_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"sending_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceAccountSendingAttributesPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	sendingEnabled := d.Get("sending_enabled").(bool)
+
+	if err := putAccountSendingAttributes(conn, sendingEnabled); err != nil {
+		return fmt.Errorf("error putting SESv2 Account sending attributes (sending enabled: %t): %w", sendingEnabled, err)
+	}
+
+	// Dedicated IP auto-warmup is left at its current value unless configured.
+	if v, ok := d.GetOkExists("dedicated_ip_auto_warmup_enabled"); ok && (d.IsNewResource() || d.HasChange("dedicated_ip_auto_warmup_enabled")) {
+		autoWarmupEnabled := v.(bool)
+
+		if err := putAccountDedicatedIPWarmupAttributes(conn, autoWarmupEnabled); err != nil {
+			return fmt.Errorf("error putting SESv2 Account dedicated IP warmup attributes (auto warmup enabled: %t): %w", autoWarmupEnabled, err)
+		}
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	return resourceAccountSendingAttributesRead(d, meta)
+}
+
+func resourceAccountSendingAttributesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	output, err := FindAccount(conn)
+
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Account (%s): %w", d.Id(), err)
+	}
+
+	d.Set("dedicated_ip_auto_warmup_enabled", output.DedicatedIpAutoWarmupEnabled)
+	d.Set("production_access_enabled", output.ProductionAccessEnabled)
+	d.Set("sending_enabled", output.SendingEnabled)
+
+	return nil
+}
+
+func resourceAccountSendingAttributesDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	// Removing the resource re-enables sending for the account.
+	log.Printf("[DEBUG] Re-enabling SESv2 Account (%s) sending", d.Id())
+	if err := putAccountSendingAttributes(conn, true); err != nil {
+		return fmt.Errorf("error re-enabling SESv2 Account (%s) sending: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putAccountSendingAttributes(conn *sesv2.SESV2, sendingEnabled bool) error {
+	input := &sesv2.PutAccountSendingAttributesInput{
+		SendingEnabled: aws.Bool(sendingEnabled),
+	}
+
+	log.Printf("[DEBUG] Putting SESv2 Account sending attributes: %s", input)
+	_, err := conn.PutAccountSendingAttributes(input)
+
+	return err
+}
+
+func putAccountDedicatedIPWarmupAttributes(conn *sesv2.SESV2, autoWarmupEnabled bool) error {
+	input := &sesv2.PutAccountDedicatedIpWarmupAttributesInput{
+		AutoWarmupEnabled: aws.Bool(autoWarmupEnabled),
+	}
+
+	log.Printf("[DEBUG] Putting SESv2 Account dedicated IP warmup attributes: %s", input)
+	_, err := conn.PutAccountDedicatedIpWarmupAttributes(input)
+
+	return err
+}
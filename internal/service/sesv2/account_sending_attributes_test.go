@@ -0,0 +1,145 @@
+package sesv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsesv2 "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
+)
+
+func TestAccSESV2AccountSendingAttributes_basic(t *testing.T) {
+	resourceName := "aws_sesv2_account_sending_attributes.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(sesv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccountSendingAttributesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSendingAttributesConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSendingAttributesSendingEnabled(false),
+					acctest.CheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "production_access_enabled"),
+					resource.TestCheckResourceAttr(resourceName, "sending_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccountSendingAttributesConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSendingAttributesSendingEnabled(true),
+					resource.TestCheckResourceAttr(resourceName, "sending_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSESV2AccountSendingAttributes_dedicatedIPAutoWarmupEnabled(t *testing.T) {
+	resourceName := "aws_sesv2_account_sending_attributes.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(sesv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccountSendingAttributesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSendingAttributesDedicatedIPAutoWarmupEnabledConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSendingAttributesDedicatedIPAutoWarmupEnabled(false),
+					resource.TestCheckResourceAttr(resourceName, "dedicated_ip_auto_warmup_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccountSendingAttributesDedicatedIPAutoWarmupEnabledConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSendingAttributesDedicatedIPAutoWarmupEnabled(true),
+					resource.TestCheckResourceAttr(resourceName, "dedicated_ip_auto_warmup_enabled", "true"),
+				),
+			},
+			{
+				// Omitting the argument leaves the current value unchanged.
+				Config: testAccAccountSendingAttributesConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSendingAttributesDedicatedIPAutoWarmupEnabled(true),
+					resource.TestCheckResourceAttr(resourceName, "dedicated_ip_auto_warmup_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+// Removing the resource re-enables sending for the account.
+func testAccCheckAccountSendingAttributesDestroy(s *terraform.State) error {
+	return testAccCheckAccountSendingAttributesSendingEnabled(true)(s)
+}
+
+func testAccCheckAccountSendingAttributesSendingEnabled(want bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn
+
+		output, err := tfsesv2.FindAccount(conn)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.BoolValue(output.SendingEnabled); got != want {
+			return fmt.Errorf("SESv2 Account sending enabled = %t, want %t", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccountSendingAttributesDedicatedIPAutoWarmupEnabled(want bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn
+
+		output, err := tfsesv2.FindAccount(conn)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.BoolValue(output.DedicatedIpAutoWarmupEnabled); got != want {
+			return fmt.Errorf("SESv2 Account dedicated IP auto warmup enabled = %t, want %t", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccAccountSendingAttributesConfig(sendingEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_account_sending_attributes" "test" {
+  sending_enabled = %[1]t
+}
+`, sendingEnabled)
+}
+
+func testAccAccountSendingAttributesDedicatedIPAutoWarmupEnabledConfig(autoWarmupEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_account_sending_attributes" "test" {
+  dedicated_ip_auto_warmup_enabled = %[1]t
+}
+`, autoWarmupEnabled)
+}
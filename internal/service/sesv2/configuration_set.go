@@ -0,0 +1,304 @@
+package sesv2
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceConfigurationSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceConfigurationSetCreate,
+		Read:   resourceConfigurationSetRead,
+		Update: resourceConfigurationSetUpdate,
+		Delete: resourceConfigurationSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_set_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"delivery_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sending_pool_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tls_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      sesv2.TlsPolicyOptional,
+							ValidateFunc: validation.StringInSlice(sesv2.TlsPolicy_Values(), false),
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"tracking_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_redirect_domain": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 253),
+								validation.StringMatch(regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z]{2,63}$`), "must be a valid hostname"),
+							),
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceConfigurationSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("configuration_set_name").(string)
+	input := &sesv2.CreateConfigurationSetInput{
+		ConfigurationSetName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("delivery_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DeliveryOptions = expandDeliveryOptions(v.([]interface{})[0].(map[string]interface{}))
+
+		if err := checkDedicatedIPPoolExists(conn, aws.StringValue(input.DeliveryOptions.SendingPoolName)); err != nil {
+			return fmt.Errorf("error creating SESv2 Configuration Set (%s): %w", name, err)
+		}
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	if v, ok := d.GetOk("tracking_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TrackingOptions = expandTrackingOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating SESv2 Configuration Set: %s", input)
+	_, err := conn.CreateConfigurationSet(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SESv2 Configuration Set (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceConfigurationSetRead(d, meta)
+}
+
+func resourceConfigurationSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindConfigurationSetByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESv2 Configuration Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Configuration Set (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "ses",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("configuration-set/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("configuration_set_name", output.ConfigurationSetName)
+
+	// SES reports the default delivery options for configuration sets that never set them.
+	if v := output.DeliveryOptions; v != nil && (v.SendingPoolName != nil || aws.StringValue(v.TlsPolicy) != sesv2.TlsPolicyOptional || len(d.Get("delivery_options").([]interface{})) > 0) {
+		if err := d.Set("delivery_options", flattenDeliveryOptions(v)); err != nil {
+			return fmt.Errorf("error setting delivery_options: %w", err)
+		}
+	} else {
+		d.Set("delivery_options", nil)
+	}
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	if v := output.TrackingOptions; v != nil && aws.StringValue(v.CustomRedirectDomain) != "" {
+		if err := d.Set("tracking_options", flattenTrackingOptions(v)); err != nil {
+			return fmt.Errorf("error setting tracking_options: %w", err)
+		}
+	} else {
+		d.Set("tracking_options", nil)
+	}
+
+	return nil
+}
+
+func resourceConfigurationSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	if d.HasChange("delivery_options") {
+		input := &sesv2.PutConfigurationSetDeliveryOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("delivery_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			apiObject := expandDeliveryOptions(v.([]interface{})[0].(map[string]interface{}))
+
+			if err := checkDedicatedIPPoolExists(conn, aws.StringValue(apiObject.SendingPoolName)); err != nil {
+				return fmt.Errorf("error updating SESv2 Configuration Set (%s) delivery options: %w", d.Id(), err)
+			}
+
+			input.SendingPoolName = apiObject.SendingPoolName
+			input.TlsPolicy = apiObject.TlsPolicy
+		}
+
+		log.Printf("[DEBUG] Putting SESv2 Configuration Set delivery options: %s", input)
+		_, err := conn.PutConfigurationSetDeliveryOptions(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) delivery options: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tracking_options") {
+		input := &sesv2.PutConfigurationSetTrackingOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("tracking_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.CustomRedirectDomain = expandTrackingOptions(v.([]interface{})[0].(map[string]interface{})).CustomRedirectDomain
+		}
+
+		log.Printf("[DEBUG] Putting SESv2 Configuration Set tracking options: %s", input)
+		_, err := conn.PutConfigurationSetTrackingOptions(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) tracking options: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceConfigurationSetRead(d, meta)
+}
+
+func resourceConfigurationSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	log.Printf("[DEBUG] Deleting SESv2 Configuration Set: %s", d.Id())
+	_, err := conn.DeleteConfigurationSet(&sesv2.DeleteConfigurationSetInput{
+		ConfigurationSetName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SESv2 Configuration Set (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// checkDedicatedIPPoolExists returns an error if the specified sending pool is not an existing dedicated IP pool.
+// The pool must exist before a configuration set can reference it.
+func checkDedicatedIPPoolExists(conn *sesv2.SESV2, name string) error {
+	if name == "" {
+		return nil
+	}
+
+	_, err := FindDedicatedIPPoolByName(conn, name)
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("dedicated IP pool (%s) does not exist", name)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading dedicated IP pool (%s): %w", name, err)
+	}
+
+	return nil
+}
+
+func expandDeliveryOptions(tfMap map[string]interface{}) *sesv2.DeliveryOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.DeliveryOptions{}
+
+	if v, ok := tfMap["sending_pool_name"].(string); ok && v != "" {
+		apiObject.SendingPoolName = aws.String(v)
+	}
+
+	if v, ok := tfMap["tls_policy"].(string); ok && v != "" {
+		apiObject.TlsPolicy = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTrackingOptions(tfMap map[string]interface{}) *sesv2.TrackingOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.TrackingOptions{}
+
+	if v, ok := tfMap["custom_redirect_domain"].(string); ok && v != "" {
+		apiObject.CustomRedirectDomain = aws.String(v)
+	}
+
+	return apiObject
+}
@@ -0,0 +1,217 @@
+package sesv2
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceConfigurationSet() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceConfigurationSetRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_set_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"delivery_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sending_pool_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tls_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"reputation_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_fresh_start": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reputation_metrics_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"sending_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sending_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"suppression_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"suppressed_reasons": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"tracking_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_redirect_domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceConfigurationSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name := d.Get("configuration_set_name").(string)
+
+	output, err := FindConfigurationSetByName(conn, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Configuration Set (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ConfigurationSetName))
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "ses",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("configuration-set/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("configuration_set_name", output.ConfigurationSetName)
+
+	if err := d.Set("delivery_options", flattenDeliveryOptions(output.DeliveryOptions)); err != nil {
+		return fmt.Errorf("error setting delivery_options: %w", err)
+	}
+
+	if err := d.Set("reputation_options", flattenReputationOptions(output.ReputationOptions)); err != nil {
+		return fmt.Errorf("error setting reputation_options: %w", err)
+	}
+
+	if err := d.Set("sending_options", flattenSendingOptions(output.SendingOptions)); err != nil {
+		return fmt.Errorf("error setting sending_options: %w", err)
+	}
+
+	if err := d.Set("suppression_options", flattenSuppressionOptions(output.SuppressionOptions)); err != nil {
+		return fmt.Errorf("error setting suppression_options: %w", err)
+	}
+
+	if err := d.Set("tracking_options", flattenTrackingOptions(output.TrackingOptions)); err != nil {
+		return fmt.Errorf("error setting tracking_options: %w", err)
+	}
+
+	if err := d.Set("tags", KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func flattenDeliveryOptions(apiObject *sesv2.DeliveryOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"sending_pool_name": aws.StringValue(apiObject.SendingPoolName),
+		"tls_policy":        aws.StringValue(apiObject.TlsPolicy),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenReputationOptions(apiObject *sesv2.ReputationOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"reputation_metrics_enabled": aws.BoolValue(apiObject.ReputationMetricsEnabled),
+	}
+
+	if v := apiObject.LastFreshStart; v != nil {
+		tfMap["last_fresh_start"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSendingOptions(apiObject *sesv2.SendingOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"sending_enabled": aws.BoolValue(apiObject.SendingEnabled),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSuppressionOptions(apiObject *sesv2.SuppressionOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"suppressed_reasons": flex.FlattenStringList(apiObject.SuppressedReasons),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTrackingOptions(apiObject *sesv2.TrackingOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"custom_redirect_domain": aws.StringValue(apiObject.CustomRedirectDomain),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,56 @@
+package sesv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSESV2ConfigurationSetDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_sesv2_configuration_set.test"
+	resourceName := "aws_ses_configuration_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(sesv2.EndpointsID, t) },
+		ErrorCheck: acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationSetDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "configuration_set_name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "delivery_options.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "delivery_options.0.tls_policy", sesv2.TlsPolicyRequire),
+					resource.TestCheckResourceAttr(dataSourceName, "reputation_options.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "reputation_options.0.reputation_metrics_enabled", resourceName, "reputation_metrics_enabled"),
+					resource.TestCheckResourceAttr(dataSourceName, "sending_options.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "sending_options.0.sending_enabled", resourceName, "sending_enabled"),
+					resource.TestCheckResourceAttr(dataSourceName, "tracking_options.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigurationSetDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_configuration_set" "test" {
+  name = %[1]q
+
+  delivery_options {
+    tls_policy = "Require"
+  }
+}
+
+data "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = aws_ses_configuration_set.test.name
+}
+`, rName)
+}
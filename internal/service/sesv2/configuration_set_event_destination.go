@@ -0,0 +1,419 @@
+package sesv2
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceConfigurationSetEventDestination() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceConfigurationSetEventDestinationCreate,
+		Read:   resourceConfigurationSetEventDestinationRead,
+		Update: resourceConfigurationSetEventDestinationUpdate,
+		Delete: resourceConfigurationSetEventDestinationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"configuration_set_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"event_destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloud_watch_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimension_configuration": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"default_dimension_value": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.All(
+														validation.StringLenBetween(1, 256),
+														validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z_.-]+$`), "must contain only alphanumeric, underscore, period, and hyphen characters"),
+													),
+												},
+												"dimension_name": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.All(
+														validation.StringLenBetween(1, 256),
+														validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z_:-]+$`), "must contain only alphanumeric, underscore, colon, and hyphen characters"),
+													),
+												},
+												"dimension_value_source": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(sesv2.DimensionValueSource_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+							ExactlyOneOf: []string{
+								"event_destination.0.cloud_watch_destination",
+								"event_destination.0.kinesis_firehose_destination",
+								"event_destination.0.pinpoint_destination",
+								"event_destination.0.sns_destination",
+							},
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"kinesis_firehose_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delivery_stream_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"iam_role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+							ExactlyOneOf: []string{
+								"event_destination.0.cloud_watch_destination",
+								"event_destination.0.kinesis_firehose_destination",
+								"event_destination.0.pinpoint_destination",
+								"event_destination.0.sns_destination",
+							},
+						},
+						"matching_event_types": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(sesv2.EventType_Values(), false),
+							},
+						},
+						"pinpoint_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"application_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+							ExactlyOneOf: []string{
+								"event_destination.0.cloud_watch_destination",
+								"event_destination.0.kinesis_firehose_destination",
+								"event_destination.0.pinpoint_destination",
+								"event_destination.0.sns_destination",
+							},
+						},
+						"sns_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"topic_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+							ExactlyOneOf: []string{
+								"event_destination.0.cloud_watch_destination",
+								"event_destination.0.kinesis_firehose_destination",
+								"event_destination.0.pinpoint_destination",
+								"event_destination.0.sns_destination",
+							},
+						},
+					},
+				},
+			},
+			"event_destination_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z_-]+$`), "must contain only alphanumeric, underscore, and hyphen characters"),
+				),
+			},
+		},
+	}
+}
+
+func resourceConfigurationSetEventDestinationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	configurationSetName := d.Get("configuration_set_name").(string)
+	eventDestinationName := d.Get("event_destination_name").(string)
+	id := ConfigurationSetEventDestinationCreateResourceID(configurationSetName, eventDestinationName)
+	input := &sesv2.CreateConfigurationSetEventDestinationInput{
+		ConfigurationSetName: aws.String(configurationSetName),
+		EventDestination:     expandEventDestinationDefinition(d.Get("event_destination").([]interface{})[0].(map[string]interface{})),
+		EventDestinationName: aws.String(eventDestinationName),
+	}
+
+	log.Printf("[DEBUG] Creating SESv2 Configuration Set Event Destination: %s", input)
+	_, err := conn.CreateConfigurationSetEventDestination(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SESv2 Configuration Set Event Destination (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceConfigurationSetEventDestinationRead(d, meta)
+}
+
+func resourceConfigurationSetEventDestinationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	configurationSetName, eventDestinationName, err := ConfigurationSetEventDestinationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindConfigurationSetEventDestinationByTwoPartKey(conn, configurationSetName, eventDestinationName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESv2 Configuration Set Event Destination (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Configuration Set Event Destination (%s): %w", d.Id(), err)
+	}
+
+	d.Set("configuration_set_name", configurationSetName)
+	if err := d.Set("event_destination", []interface{}{flattenEventDestination(output)}); err != nil {
+		return fmt.Errorf("error setting event_destination: %w", err)
+	}
+	d.Set("event_destination_name", output.Name)
+
+	return nil
+}
+
+func resourceConfigurationSetEventDestinationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	configurationSetName, eventDestinationName, err := ConfigurationSetEventDestinationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	// Disabling an event destination pauses event publishing without discarding its configuration.
+	input := &sesv2.UpdateConfigurationSetEventDestinationInput{
+		ConfigurationSetName: aws.String(configurationSetName),
+		EventDestination:     expandEventDestinationDefinition(d.Get("event_destination").([]interface{})[0].(map[string]interface{})),
+		EventDestinationName: aws.String(eventDestinationName),
+	}
+
+	log.Printf("[DEBUG] Updating SESv2 Configuration Set Event Destination: %s", input)
+	_, err = conn.UpdateConfigurationSetEventDestination(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating SESv2 Configuration Set Event Destination (%s): %w", d.Id(), err)
+	}
+
+	return resourceConfigurationSetEventDestinationRead(d, meta)
+}
+
+func resourceConfigurationSetEventDestinationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	configurationSetName, eventDestinationName, err := ConfigurationSetEventDestinationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting SESv2 Configuration Set Event Destination: %s", d.Id())
+	_, err = conn.DeleteConfigurationSetEventDestination(&sesv2.DeleteConfigurationSetEventDestinationInput{
+		ConfigurationSetName: aws.String(configurationSetName),
+		EventDestinationName: aws.String(eventDestinationName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SESv2 Configuration Set Event Destination (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const configurationSetEventDestinationResourceIDSeparator = "|"
+
+func ConfigurationSetEventDestinationCreateResourceID(configurationSetName, eventDestinationName string) string {
+	parts := []string{configurationSetName, eventDestinationName}
+	id := strings.Join(parts, configurationSetEventDestinationResourceIDSeparator)
+
+	return id
+}
+
+func ConfigurationSetEventDestinationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, configurationSetEventDestinationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CONFIGURATION_SET_NAME%[2]sEVENT_DESTINATION_NAME", id, configurationSetEventDestinationResourceIDSeparator)
+}
+
+func expandEventDestinationDefinition(tfMap map[string]interface{}) *sesv2.EventDestinationDefinition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.EventDestinationDefinition{
+		Enabled: aws.Bool(tfMap["enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["cloud_watch_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CloudWatchDestination = expandCloudWatchDestination(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["kinesis_firehose_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.KinesisFirehoseDestination = &sesv2.KinesisFirehoseDestination{
+			DeliveryStreamArn: aws.String(tfMap["delivery_stream_arn"].(string)),
+			IamRoleArn:        aws.String(tfMap["iam_role_arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["matching_event_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchingEventTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["pinpoint_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PinpointDestination = &sesv2.PinpointDestination{
+			ApplicationArn: aws.String(v[0].(map[string]interface{})["application_arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["sns_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SnsDestination = &sesv2.SnsDestination{
+			TopicArn: aws.String(v[0].(map[string]interface{})["topic_arn"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandCloudWatchDestination(tfMap map[string]interface{}) *sesv2.CloudWatchDestination {
+	apiObject := &sesv2.CloudWatchDestination{}
+
+	for _, v := range tfMap["dimension_configuration"].([]interface{}) {
+		if v == nil {
+			continue
+		}
+
+		tfMap := v.(map[string]interface{})
+
+		apiObject.DimensionConfigurations = append(apiObject.DimensionConfigurations, &sesv2.CloudWatchDimensionConfiguration{
+			DefaultDimensionValue: aws.String(tfMap["default_dimension_value"].(string)),
+			DimensionName:         aws.String(tfMap["dimension_name"].(string)),
+			DimensionValueSource:  aws.String(tfMap["dimension_value_source"].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func flattenEventDestination(apiObject *sesv2.EventDestination) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled":              aws.BoolValue(apiObject.Enabled),
+		"matching_event_types": aws.StringValueSlice(apiObject.MatchingEventTypes),
+	}
+
+	if v := apiObject.CloudWatchDestination; v != nil {
+		var tfList []interface{}
+
+		for _, v := range v.DimensionConfigurations {
+			if v == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"default_dimension_value": aws.StringValue(v.DefaultDimensionValue),
+				"dimension_name":          aws.StringValue(v.DimensionName),
+				"dimension_value_source":  aws.StringValue(v.DimensionValueSource),
+			})
+		}
+
+		tfMap["cloud_watch_destination"] = []interface{}{map[string]interface{}{
+			"dimension_configuration": tfList,
+		}}
+	}
+
+	if v := apiObject.KinesisFirehoseDestination; v != nil {
+		tfMap["kinesis_firehose_destination"] = []interface{}{map[string]interface{}{
+			"delivery_stream_arn": aws.StringValue(v.DeliveryStreamArn),
+			"iam_role_arn":        aws.StringValue(v.IamRoleArn),
+		}}
+	}
+
+	if v := apiObject.PinpointDestination; v != nil {
+		tfMap["pinpoint_destination"] = []interface{}{map[string]interface{}{
+			"application_arn": aws.StringValue(v.ApplicationArn),
+		}}
+	}
+
+	if v := apiObject.SnsDestination; v != nil {
+		tfMap["sns_destination"] = []interface{}{map[string]interface{}{
+			"topic_arn": aws.StringValue(v.TopicArn),
+		}}
+	}
+
+	return tfMap
+}
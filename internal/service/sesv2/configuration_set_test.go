@@ -0,0 +1,347 @@
+package sesv2_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsesv2 "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSESV2ConfigurationSet_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(sesv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationSetConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "ses", fmt.Sprintf("configuration-set/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "configuration_set_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSESV2ConfigurationSet_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(sesv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationSetConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsesv2.ResourceConfigurationSet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSESV2ConfigurationSet_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(sesv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationSetTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationSetTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccConfigurationSetTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSESV2ConfigurationSet_deliveryOptionsSendingPoolName(t *testing.T) {
+	poolName1, poolName2 := testAccConfigurationSetSendingPoolsPreCheck(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(sesv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationSetDeliveryOptionsConfig(rName, poolName1, sesv2.TlsPolicyOptional),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.0.sending_pool_name", poolName1),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.0.tls_policy", sesv2.TlsPolicyOptional),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationSetDeliveryOptionsConfig(rName, poolName2, sesv2.TlsPolicyRequire),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.0.sending_pool_name", poolName2),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.0.tls_policy", sesv2.TlsPolicyRequire),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSESV2ConfigurationSet_trackingOptions(t *testing.T) {
+	domain1, domain2 := testAccConfigurationSetCustomRedirectDomainsPreCheck(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(sesv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationSetTrackingOptionsConfig(rName, domain1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tracking_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tracking_options.0.custom_redirect_domain", domain1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationSetTrackingOptionsConfig(rName, domain2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tracking_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tracking_options.0.custom_redirect_domain", domain2),
+				),
+			},
+			{
+				Config: testAccConfigurationSetConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tracking_options.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSESV2ConfigurationSet_TrackingOptions_invalidDomain(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(sesv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfigurationSetTrackingOptionsConfig(rName, "https://track.example.com"),
+				ExpectError: regexp.MustCompile(`must be a valid hostname`),
+			},
+		},
+	})
+}
+
+// testAccConfigurationSetSendingPoolsPreCheck returns the two dedicated IP pools to use for testing.
+// Dedicated IP pools are not managed by this provider and must be created outside of the tests.
+func testAccConfigurationSetSendingPoolsPreCheck(t *testing.T) (string, string) {
+	poolName1Key := "AWS_SESV2_DEDICATED_IP_POOL_NAME"
+	poolName1 := os.Getenv(poolName1Key)
+	if poolName1 == "" {
+		t.Skipf("Environment variable %s is not set", poolName1Key)
+	}
+
+	poolName2Key := "AWS_SESV2_DEDICATED_IP_POOL_NAME_ALTERNATE"
+	poolName2 := os.Getenv(poolName2Key)
+	if poolName2 == "" {
+		t.Skipf("Environment variable %s is not set", poolName2Key)
+	}
+
+	return poolName1, poolName2
+}
+
+// testAccConfigurationSetCustomRedirectDomainsPreCheck returns the two custom redirect domains to use for testing.
+// Each domain must be a verified SES identity with a CNAME record pointing to the SES tracking domain.
+func testAccConfigurationSetCustomRedirectDomainsPreCheck(t *testing.T) (string, string) {
+	domain1Key := "AWS_SESV2_CUSTOM_REDIRECT_DOMAIN"
+	domain1 := os.Getenv(domain1Key)
+	if domain1 == "" {
+		t.Skipf("Environment variable %s is not set", domain1Key)
+	}
+
+	domain2Key := "AWS_SESV2_CUSTOM_REDIRECT_DOMAIN_ALTERNATE"
+	domain2 := os.Getenv(domain2Key)
+	if domain2 == "" {
+		t.Skipf("Environment variable %s is not set", domain2Key)
+	}
+
+	return domain1, domain2
+}
+
+func testAccCheckConfigurationSetDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_configuration_set" {
+			continue
+		}
+
+		_, err := tfsesv2.FindConfigurationSetByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SESv2 Configuration Set %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckConfigurationSetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SESv2 Configuration Set ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn
+
+		_, err := tfsesv2.FindConfigurationSetByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccConfigurationSetConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+}
+`, rName)
+}
+
+func testAccConfigurationSetTags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccConfigurationSetTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccConfigurationSetDeliveryOptionsConfig(rName, poolName, tlsPolicy string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+
+  delivery_options {
+    sending_pool_name = %[2]q
+    tls_policy        = %[3]q
+  }
+}
+`, rName, poolName, tlsPolicy)
+}
+
+func testAccConfigurationSetTrackingOptionsConfig(rName, domain string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+
+  tracking_options {
+    custom_redirect_domain = %[2]q
+  }
+}
+`, rName, domain)
+}
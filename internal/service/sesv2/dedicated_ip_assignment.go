@@ -0,0 +1,161 @@
+package sesv2
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// The pool that dedicated IPs are returned to when they are unassigned.
+	defaultDedicatedPoolName = "ses-default-dedicated-pool"
+)
+
+func ResourceDedicatedIPAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedIPAssignmentCreate,
+		Read:   resourceDedicatedIPAssignmentRead,
+		Delete: resourceDedicatedIPAssignmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"destination_pool_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"warmup_percentage": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"warmup_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDedicatedIPAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	ip := d.Get("ip").(string)
+	poolName := d.Get("destination_pool_name").(string)
+	id := DedicatedIPAssignmentCreateResourceID(ip, poolName)
+
+	if err := putDedicatedIPInPool(conn, ip, poolName); err != nil {
+		return fmt.Errorf("error creating SESv2 Dedicated IP Assignment (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceDedicatedIPAssignmentRead(d, meta)
+}
+
+func resourceDedicatedIPAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	ip, poolName, err := DedicatedIPAssignmentParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindDedicatedIPByIP(conn, ip)
+
+	if err == nil && aws.StringValue(output.PoolName) != poolName {
+		err = &resource.NotFoundError{
+			Message: fmt.Sprintf("dedicated IP %s is assigned to pool %s", ip, aws.StringValue(output.PoolName)),
+		}
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESv2 Dedicated IP Assignment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Dedicated IP Assignment (%s): %w", d.Id(), err)
+	}
+
+	d.Set("destination_pool_name", output.PoolName)
+	d.Set("ip", output.Ip)
+	d.Set("warmup_percentage", output.WarmupPercentage)
+	d.Set("warmup_status", output.WarmupStatus)
+
+	return nil
+}
+
+func resourceDedicatedIPAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	ip, _, err := DedicatedIPAssignmentParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	// Removing the resource returns the dedicated IP to the default pool.
+	log.Printf("[DEBUG] Deleting SESv2 Dedicated IP Assignment: %s", d.Id())
+	err = putDedicatedIPInPool(conn, ip, defaultDedicatedPoolName)
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SESv2 Dedicated IP Assignment (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putDedicatedIPInPool(conn *sesv2.SESV2, ip, poolName string) error {
+	input := &sesv2.PutDedicatedIpInPoolInput{
+		DestinationPoolName: aws.String(poolName),
+		Ip:                  aws.String(ip),
+	}
+
+	log.Printf("[DEBUG] Putting SESv2 Dedicated IP in pool: %s", input)
+	_, err := conn.PutDedicatedIpInPool(input)
+
+	return err
+}
+
+const dedicatedIPAssignmentResourceIDSeparator = ","
+
+func DedicatedIPAssignmentCreateResourceID(ip, poolName string) string {
+	parts := []string{ip, poolName}
+	id := strings.Join(parts, dedicatedIPAssignmentResourceIDSeparator)
+
+	return id
+}
+
+func DedicatedIPAssignmentParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, dedicatedIPAssignmentResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected IP%[2]sDESTINATION_POOL_NAME", id, dedicatedIPAssignmentResourceIDSeparator)
+}
@@ -0,0 +1,137 @@
+package sesv2_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsesv2 "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSESV2DedicatedIPAssignment_basic(t *testing.T) {
+	ip, poolName := testAccDedicatedIPAssignmentPreCheck(t)
+	resourceName := "aws_sesv2_dedicated_ip_assignment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(sesv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDedicatedIPAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDedicatedIPAssignmentConfig(ip, poolName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDedicatedIPAssignmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_pool_name", poolName),
+					resource.TestCheckResourceAttr(resourceName, "ip", ip),
+					resource.TestCheckResourceAttrSet(resourceName, "warmup_percentage"),
+					resource.TestCheckResourceAttrSet(resourceName, "warmup_status"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccDedicatedIPAssignmentPreCheck returns the dedicated IP and the dedicated IP pool to use for testing.
+// Dedicated IPs are leased through AWS Support and cannot be provisioned by the tests.
+func testAccDedicatedIPAssignmentPreCheck(t *testing.T) (string, string) {
+	ipKey := "AWS_SESV2_DEDICATED_IP"
+	ip := os.Getenv(ipKey)
+	if ip == "" {
+		t.Skipf("Environment variable %s is not set", ipKey)
+	}
+
+	poolNameKey := "AWS_SESV2_DEDICATED_IP_POOL_NAME"
+	poolName := os.Getenv(poolNameKey)
+	if poolName == "" {
+		t.Skipf("Environment variable %s is not set", poolNameKey)
+	}
+
+	return ip, poolName
+}
+
+func testAccCheckDedicatedIPAssignmentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_dedicated_ip_assignment" {
+			continue
+		}
+
+		ip, poolName, err := tfsesv2.DedicatedIPAssignmentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := tfsesv2.FindDedicatedIPByIP(conn, ip)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.PoolName) == poolName {
+			return fmt.Errorf("SESv2 Dedicated IP Assignment %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDedicatedIPAssignmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SESv2 Dedicated IP Assignment ID is set")
+		}
+
+		ip, poolName, err := tfsesv2.DedicatedIPAssignmentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn
+
+		output, err := tfsesv2.FindDedicatedIPByIP(conn, ip)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.StringValue(output.PoolName); got != poolName {
+			return fmt.Errorf("SESv2 Dedicated IP (%s) pool = %s, want %s", ip, got, poolName)
+		}
+
+		return nil
+	}
+}
+
+func testAccDedicatedIPAssignmentConfig(ip, poolName string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_dedicated_ip_assignment" "test" {
+  ip                    = %[1]q
+  destination_pool_name = %[2]q
+}
+`, ip, poolName)
+}
@@ -0,0 +1,136 @@
+package sesv2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceEmailIdentityFeedbackAttributes() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEmailIdentityFeedbackAttributesCreate,
+		Read:   resourceEmailIdentityFeedbackAttributesRead,
+		Update: resourceEmailIdentityFeedbackAttributesUpdate,
+		Delete: resourceEmailIdentityFeedbackAttributesDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"email_forwarding_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"email_identity": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceEmailIdentityFeedbackAttributesCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	emailIdentity := d.Get("email_identity").(string)
+
+	// PutEmailIdentityFeedbackAttributes does not distinguish a missing email identity from other bad requests.
+	_, err := FindEmailIdentityByID(conn, emailIdentity)
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("error putting SESv2 Email Identity (%s) Feedback Attributes: email identity not found", emailIdentity)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Email Identity (%s): %w", emailIdentity, err)
+	}
+
+	if err := putEmailIdentityFeedbackAttributes(conn, emailIdentity, d.Get("email_forwarding_enabled").(bool)); err != nil {
+		return err
+	}
+
+	d.SetId(emailIdentity)
+
+	return resourceEmailIdentityFeedbackAttributesRead(d, meta)
+}
+
+func resourceEmailIdentityFeedbackAttributesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	output, err := FindEmailIdentityByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESv2 Email Identity (%s) not found, removing Feedback Attributes from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Email Identity (%s) Feedback Attributes: %w", d.Id(), err)
+	}
+
+	d.Set("email_forwarding_enabled", output.FeedbackForwardingStatus)
+	d.Set("email_identity", d.Id())
+
+	return nil
+}
+
+func resourceEmailIdentityFeedbackAttributesUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	if err := putEmailIdentityFeedbackAttributes(conn, d.Id(), d.Get("email_forwarding_enabled").(bool)); err != nil {
+		return err
+	}
+
+	return resourceEmailIdentityFeedbackAttributesRead(d, meta)
+}
+
+func resourceEmailIdentityFeedbackAttributesDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	// Email forwarding is enabled by default.
+	input := &sesv2.PutEmailIdentityFeedbackAttributesInput{
+		EmailForwardingEnabled: aws.Bool(true),
+		EmailIdentity:          aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting SESv2 Email Identity Feedback Attributes: %s", d.Id())
+	_, err := conn.PutEmailIdentityFeedbackAttributes(input)
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SESv2 Email Identity (%s) Feedback Attributes: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putEmailIdentityFeedbackAttributes(conn *sesv2.SESV2, emailIdentity string, emailForwardingEnabled bool) error {
+	input := &sesv2.PutEmailIdentityFeedbackAttributesInput{
+		EmailForwardingEnabled: aws.Bool(emailForwardingEnabled),
+		EmailIdentity:          aws.String(emailIdentity),
+	}
+
+	log.Printf("[DEBUG] Putting SESv2 Email Identity Feedback Attributes: %s", input)
+	_, err := conn.PutEmailIdentityFeedbackAttributes(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting SESv2 Email Identity (%s) Feedback Attributes: %w", emailIdentity, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package sesv2_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsesv2 "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSESV2EmailIdentityFeedbackAttributes_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	resourceName := "aws_sesv2_email_identity_feedback_attributes.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(sesv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmailIdentityFeedbackAttributesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailIdentityFeedbackAttributesConfig(rName, domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityFeedbackAttributesExists(resourceName, false),
+					resource.TestCheckResourceAttr(resourceName, "email_forwarding_enabled", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "email_identity", "aws_ses_domain_identity.test", "domain"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEmailIdentityFeedbackAttributesConfig(rName, domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityFeedbackAttributesExists(resourceName, true),
+					resource.TestCheckResourceAttr(resourceName, "email_forwarding_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccEmailIdentityFeedbackAttributesConfig(rName, domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityFeedbackAttributesExists(resourceName, false),
+					resource.TestCheckResourceAttr(resourceName, "email_forwarding_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSESV2EmailIdentityFeedbackAttributes_emailIdentityNotFound(t *testing.T) {
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(sesv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmailIdentityFeedbackAttributesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEmailIdentityFeedbackAttributesStaticConfig(domain),
+				ExpectError: regexp.MustCompile(`email identity not found`),
+			},
+		},
+	})
+}
+
+func testAccCheckEmailIdentityFeedbackAttributesDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_email_identity_feedback_attributes" {
+			continue
+		}
+
+		output, err := tfsesv2.FindEmailIdentityByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if !aws.BoolValue(output.FeedbackForwardingStatus) {
+			return fmt.Errorf("SESv2 Email Identity (%s) email forwarding is still disabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckEmailIdentityFeedbackAttributesExists(n string, emailForwardingEnabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SESv2 Email Identity Feedback Attributes ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn
+
+		output, err := tfsesv2.FindEmailIdentityByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.BoolValue(output.FeedbackForwardingStatus); got != emailForwardingEnabled {
+			return fmt.Errorf("SESv2 Email Identity (%s) email forwarding enabled is %s, expected %s", rs.Primary.ID, strconv.FormatBool(got), strconv.FormatBool(emailForwardingEnabled))
+		}
+
+		return nil
+	}
+}
+
+func testAccEmailIdentityFeedbackAttributesConfig(rName, domain string, emailForwardingEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_ses_domain_identity" "test" {
+  domain = %[2]q
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+# Email forwarding can only be disabled when bounce and complaint notifications are published to SNS.
+resource "aws_ses_identity_notification_topic" "bounce" {
+  identity          = aws_ses_domain_identity.test.domain
+  notification_type = "Bounce"
+  topic_arn         = aws_sns_topic.test.arn
+}
+
+resource "aws_ses_identity_notification_topic" "complaint" {
+  identity          = aws_ses_domain_identity.test.domain
+  notification_type = "Complaint"
+  topic_arn         = aws_sns_topic.test.arn
+}
+
+resource "aws_sesv2_email_identity_feedback_attributes" "test" {
+  email_identity           = aws_ses_domain_identity.test.domain
+  email_forwarding_enabled = %[3]t
+
+  depends_on = [
+    aws_ses_identity_notification_topic.bounce,
+    aws_ses_identity_notification_topic.complaint,
+  ]
+}
+`, rName, domain, emailForwardingEnabled)
+}
+
+func testAccEmailIdentityFeedbackAttributesStaticConfig(domain string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_email_identity_feedback_attributes" "test" {
+  email_identity           = %[1]q
+  email_forwarding_enabled = true
+}
+`, domain)
+}
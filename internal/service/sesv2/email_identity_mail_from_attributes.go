@@ -0,0 +1,161 @@
+package sesv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceEmailIdentityMailFromAttributes() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEmailIdentityMailFromAttributesPut,
+		Read:   resourceEmailIdentityMailFromAttributesRead,
+		Update: resourceEmailIdentityMailFromAttributesPut,
+		Delete: resourceEmailIdentityMailFromAttributesDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"behavior_on_mx_failure": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      sesv2.BehaviorOnMxFailureUseDefaultValue,
+				ValidateFunc: validation.StringInSlice(sesv2.BehaviorOnMxFailure_Values(), false),
+			},
+			"email_identity": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"mail_from_domain": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+
+		CustomizeDiff: resourceEmailIdentityMailFromAttributesCustomizeDiff,
+	}
+}
+
+func resourceEmailIdentityMailFromAttributesPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	emailIdentity := d.Get("email_identity").(string)
+	input := &sesv2.PutEmailIdentityMailFromAttributesInput{
+		BehaviorOnMxFailure: aws.String(d.Get("behavior_on_mx_failure").(string)),
+		EmailIdentity:       aws.String(emailIdentity),
+	}
+
+	if v, ok := d.GetOk("mail_from_domain"); ok {
+		input.MailFromDomain = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Putting SESv2 Email Identity MAIL FROM Attributes: %s", input)
+	_, err := conn.PutEmailIdentityMailFromAttributes(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting SESv2 Email Identity (%s) MAIL FROM Attributes: %w", emailIdentity, err)
+	}
+
+	d.SetId(emailIdentity)
+
+	return resourceEmailIdentityMailFromAttributesRead(d, meta)
+}
+
+func resourceEmailIdentityMailFromAttributesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	output, err := FindEmailIdentityByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESv2 Email Identity (%s) not found, removing MAIL FROM Attributes from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Email Identity (%s) MAIL FROM Attributes: %w", d.Id(), err)
+	}
+
+	d.Set("email_identity", d.Id())
+
+	if v := output.MailFromAttributes; v != nil {
+		d.Set("behavior_on_mx_failure", v.BehaviorOnMxFailure)
+		d.Set("mail_from_domain", v.MailFromDomain)
+	} else {
+		d.Set("behavior_on_mx_failure", sesv2.BehaviorOnMxFailureUseDefaultValue)
+		d.Set("mail_from_domain", "")
+	}
+
+	return nil
+}
+
+func resourceEmailIdentityMailFromAttributesDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	// Omitting the MAIL FROM domain disables the custom MAIL FROM domain.
+	input := &sesv2.PutEmailIdentityMailFromAttributesInput{
+		EmailIdentity: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting SESv2 Email Identity MAIL FROM Attributes: %s", d.Id())
+	_, err := conn.PutEmailIdentityMailFromAttributes(input)
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SESv2 Email Identity (%s) MAIL FROM Attributes: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceEmailIdentityMailFromAttributesCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Values that are not yet known are validated at apply time by the API.
+	if !diff.NewValueKnown("email_identity") || !diff.NewValueKnown("mail_from_domain") {
+		return nil
+	}
+
+	mailFromDomain := diff.Get("mail_from_domain").(string)
+
+	if mailFromDomain == "" {
+		return nil
+	}
+
+	emailIdentity := diff.Get("email_identity").(string)
+
+	if !isMailFromDomainOfIdentity(mailFromDomain, emailIdentity) {
+		return fmt.Errorf("mail_from_domain (%s) must be a subdomain of the domain of email_identity (%s)", mailFromDomain, emailIdentity)
+	}
+
+	return nil
+}
+
+// isMailFromDomainOfIdentity returns whether a MAIL FROM domain is a subdomain of
+// the email identity's domain, i.e. the identity itself or the domain of an email address.
+func isMailFromDomainOfIdentity(mailFromDomain, emailIdentity string) bool {
+	domain := emailIdentity
+
+	if i := strings.LastIndex(domain, "@"); i >= 0 {
+		domain = domain[i+1:]
+	}
+
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	mailFromDomain = strings.ToLower(strings.TrimSuffix(mailFromDomain, "."))
+
+	return strings.HasSuffix(mailFromDomain, "."+domain)
+}
@@ -0,0 +1,153 @@
+package sesv2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsesv2 "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSESV2EmailIdentityMailFromAttributes_basic(t *testing.T) {
+	domain := acctest.RandomDomainName()
+	mailFromDomain := fmt.Sprintf("bounce.%s", domain)
+	resourceName := "aws_sesv2_email_identity_mail_from_attributes.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(sesv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmailIdentityMailFromAttributesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailIdentityMailFromAttributesConfig(domain, mailFromDomain, sesv2.BehaviorOnMxFailureUseDefaultValue),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityMailFromAttributesExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "behavior_on_mx_failure", sesv2.BehaviorOnMxFailureUseDefaultValue),
+					resource.TestCheckResourceAttrPair(resourceName, "email_identity", "aws_ses_domain_identity.test", "domain"),
+					resource.TestCheckResourceAttr(resourceName, "mail_from_domain", mailFromDomain),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEmailIdentityMailFromAttributesConfig(domain, mailFromDomain, sesv2.BehaviorOnMxFailureRejectMessage),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityMailFromAttributesExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "behavior_on_mx_failure", sesv2.BehaviorOnMxFailureRejectMessage),
+					resource.TestCheckResourceAttr(resourceName, "mail_from_domain", mailFromDomain),
+				),
+			},
+			{
+				Config: testAccEmailIdentityMailFromAttributesConfig(domain, mailFromDomain, sesv2.BehaviorOnMxFailureUseDefaultValue),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityMailFromAttributesExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "behavior_on_mx_failure", sesv2.BehaviorOnMxFailureUseDefaultValue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSESV2EmailIdentityMailFromAttributes_notSubdomain(t *testing.T) {
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(sesv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmailIdentityMailFromAttributesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEmailIdentityMailFromAttributesStaticConfig(domain, acctest.RandomDomainName()),
+				ExpectError: regexp.MustCompile(`must be a subdomain of the domain of email_identity`),
+			},
+		},
+	})
+}
+
+func testAccCheckEmailIdentityMailFromAttributesDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_email_identity_mail_from_attributes" {
+			continue
+		}
+
+		output, err := tfsesv2.FindEmailIdentityByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if v := output.MailFromAttributes; v != nil && aws.StringValue(v.MailFromDomain) != "" {
+			return fmt.Errorf("SESv2 Email Identity (%s) MAIL FROM Attributes still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckEmailIdentityMailFromAttributesExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SESv2 Email Identity MAIL FROM Attributes ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn
+
+		output, err := tfsesv2.FindEmailIdentityByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if v := output.MailFromAttributes; v == nil || aws.StringValue(v.MailFromDomain) == "" {
+			return fmt.Errorf("SESv2 Email Identity (%s) has no MAIL FROM domain", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccEmailIdentityMailFromAttributesConfig(domain, mailFromDomain, behaviorOnMxFailure string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_domain_identity" "test" {
+  domain = %[1]q
+}
+
+resource "aws_sesv2_email_identity_mail_from_attributes" "test" {
+  email_identity         = aws_ses_domain_identity.test.domain
+  mail_from_domain       = %[2]q
+  behavior_on_mx_failure = %[3]q
+}
+`, domain, mailFromDomain, behaviorOnMxFailure)
+}
+
+func testAccEmailIdentityMailFromAttributesStaticConfig(domain, mailFromDomain string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_email_identity_mail_from_attributes" "test" {
+  email_identity   = %[1]q
+  mail_from_domain = %[2]q
+}
+`, domain, mailFromDomain)
+}
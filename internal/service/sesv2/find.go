@@ -0,0 +1,180 @@
+package sesv2
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func FindAccount(conn *sesv2.SESV2) (*sesv2.GetAccountOutput, error) {
+	input := &sesv2.GetAccountInput{}
+
+	output, err := conn.GetAccount(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindConfigurationSetByName(conn *sesv2.SESV2, name string) (*sesv2.GetConfigurationSetOutput, error) {
+	input := &sesv2.GetConfigurationSetInput{
+		ConfigurationSetName: aws.String(name),
+	}
+
+	output, err := conn.GetConfigurationSet(input)
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindConfigurationSetEventDestinationByTwoPartKey(conn *sesv2.SESV2, configurationSetName, eventDestinationName string) (*sesv2.EventDestination, error) {
+	input := &sesv2.GetConfigurationSetEventDestinationsInput{
+		ConfigurationSetName: aws.String(configurationSetName),
+	}
+
+	output, err := conn.GetConfigurationSetEventDestinations(input)
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	for _, v := range output.EventDestinations {
+		if aws.StringValue(v.Name) == eventDestinationName {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func FindEmailIdentityByID(conn *sesv2.SESV2, id string) (*sesv2.GetEmailIdentityOutput, error) {
+	input := &sesv2.GetEmailIdentityInput{
+		EmailIdentity: aws.String(id),
+	}
+
+	output, err := conn.GetEmailIdentity(input)
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindDedicatedIPByIP(conn *sesv2.SESV2, ip string) (*sesv2.DedicatedIp, error) {
+	input := &sesv2.GetDedicatedIpInput{
+		Ip: aws.String(ip),
+	}
+
+	output, err := conn.GetDedicatedIp(input)
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DedicatedIp == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.DedicatedIp, nil
+}
+
+func FindDedicatedIPPoolByName(conn *sesv2.SESV2, name string) (string, error) {
+	input := &sesv2.ListDedicatedIpPoolsInput{}
+	var output string
+
+	err := conn.ListDedicatedIpPoolsPages(input, func(page *sesv2.ListDedicatedIpPoolsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DedicatedIpPools {
+			if aws.StringValue(v) == name {
+				output = name
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == "" {
+		return "", &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}